@@ -3,6 +3,7 @@ module github.com/terraform-providers/terraform-provider-oci
 require (
 	github.com/hashicorp/hcl v0.0.0-20180404174102-ef8a98b0bbce // indirect
 	github.com/hashicorp/terraform v0.12.4-0.20190628193153-a74738cd35fc
+	github.com/mitchellh/go-homedir v1.0.0
 	github.com/oracle/oci-go-sdk v7.0.0+incompatible
 	github.com/stretchr/objx v0.1.1 // indirect
 	github.com/stretchr/testify v1.3.0
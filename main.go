@@ -3,6 +3,11 @@
 package main
 
 import (
+	"flag"
+	"log"
+	"os"
+	"strings"
+
 	"github.com/hashicorp/terraform/plugin"
 	"github.com/hashicorp/terraform/terraform"
 
@@ -10,11 +15,40 @@ import (
 )
 
 func main() {
+	var command = flag.String("command", "", "[export] Command to run. When no command is specified, the provider is served as a Terraform plugin.")
+	var compartmentId = flag.String("compartment_id", "", "[export] OCID of the compartment to export.")
+	var outputPath = flag.String("output_path", "", "[export] Path to the directory where the generated configuration and state are written.")
+	var services = flag.String("services", "", "[export] Comma-separated list of services to export. All supported services are exported by default.")
+	flag.Parse()
+
 	provider.PrintVersion()
 
-	plugin.Serve(&plugin.ServeOpts{
-		ProviderFunc: func() terraform.ResourceProvider {
-			return provider.Provider(provider.ProviderConfig)
-		},
-	})
+	switch *command {
+	case "":
+		plugin.Serve(&plugin.ServeOpts{
+			ProviderFunc: func() terraform.ResourceProvider {
+				return provider.Provider(provider.ProviderConfig)
+			},
+		})
+		// the plugin is served until Terraform shuts it down
+		provider.FlushMetrics()
+	case "export":
+		args := &provider.ExportCommandArgs{
+			CompartmentId: compartmentId,
+			OutputDir:     outputPath,
+		}
+		if *services != "" {
+			args.Services = strings.Split(*services, ",")
+		}
+
+		err := provider.RunExportCommand(args)
+		provider.FlushMetrics()
+		if err != nil {
+			log.Printf("%v", err)
+			os.Exit(1)
+		}
+	default:
+		log.Printf("[ERROR] unknown command '%s'", *command)
+		os.Exit(1)
+	}
 }
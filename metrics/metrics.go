@@ -0,0 +1,115 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package metrics
+
+import (
+	"log"
+	"time"
+)
+
+// ResourceDuration is the duration of a create, update or delete of a resource, including the wait for its state
+type ResourceDuration struct {
+	// Time is when the operation ended
+	Time      time.Time
+	Tenancy   string
+	Region    string
+	Resource  string
+	Operation string
+	Result    string
+	Duration  time.Duration
+}
+
+// ApiCall is a single HTTP call to an OCI service, every retry of an operation is a separate call
+type ApiCall struct {
+	// Time is when the call was sent
+	Time      time.Time
+	Tenancy   string
+	Region    string
+	Service   string
+	Operation string
+	// StatusCode is 0 when no response was received
+	StatusCode int
+	// Attempt is 1 for the first call of an operation and is incremented by every retry
+	Attempt      uint
+	OpcRequestId string
+	Latency      time.Duration
+	Error        string
+}
+
+// Sink receives the metrics of the provider, it must be safe for concurrent use
+type Sink interface {
+	WriteResourceDuration(metric ResourceDuration) error
+	WriteApiCall(metric ApiCall) error
+}
+
+// defaultSinks are used by the providers that don't configure any sink
+var defaultSinks []Sink
+
+// HasDefaultSinks returns whether the metrics are written without any sink configured in the provider block
+func HasDefaultSinks() bool {
+	return len(defaultSinks) > 0
+}
+
+// Recorder writes the metrics of a provider to its sinks, the tenancy and region of the provider are added to every
+// metric. A nil Recorder doesn't write any metric.
+type Recorder struct {
+	tenancy string
+	region  string
+	sinks   []Sink
+}
+
+// NewRecorder returns a recorder writing to the given sinks, or to the default sinks if none is given
+func NewRecorder(tenancyOcid, providerRegion string, sinks ...Sink) *Recorder {
+	if len(sinks) == 0 {
+		sinks = defaultSinks
+	}
+	return &Recorder{
+		tenancy: tenancyOcid,
+		region:  providerRegion,
+		sinks:   sinks,
+	}
+}
+
+// ShouldWriteMetrics returns whether any sink is configured
+func (r *Recorder) ShouldWriteMetrics() bool {
+	return r != nil && len(r.sinks) > 0
+}
+
+// SaveResourceDurationMetric writes the duration in milliseconds of an operation on a resource to all the sinks
+func (r *Recorder) SaveResourceDurationMetric(resource, operation, result string, duration int64) {
+	if !r.ShouldWriteMetrics() {
+		return
+	}
+
+	metric := ResourceDuration{
+		Time:      time.Now().UTC(),
+		Tenancy:   r.tenancy,
+		Region:    r.region,
+		Resource:  resource,
+		Operation: operation,
+		Result:    result,
+		Duration:  time.Duration(duration) * time.Millisecond,
+	}
+	for _, sink := range r.sinks {
+		if err := sink.WriteResourceDuration(metric); err != nil {
+			log.Printf("[WARN] metrics : save metrics got error: %s", err.Error())
+		}
+	}
+}
+
+// SaveApiCallMetric writes an API call to all the sinks, the region of the provider is used if the call has none
+func (r *Recorder) SaveApiCallMetric(metric ApiCall) {
+	if !r.ShouldWriteMetrics() {
+		return
+	}
+
+	metric.Tenancy = r.tenancy
+	if metric.Region == "" {
+		metric.Region = r.region
+	}
+	for _, sink := range r.sinks {
+		if err := sink.WriteApiCall(metric); err != nil {
+			log.Printf("[WARN] metrics : save metrics got error: %s", err.Error())
+		}
+	}
+}
@@ -0,0 +1,247 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package metrics
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+var (
+	testResourceDuration = ResourceDuration{
+		Time:      time.Date(2019, 8, 20, 10, 0, 30, 0, time.UTC),
+		Tenancy:   "ocid1.tenancy.oc1..aaaa",
+		Region:    "us-phoenix-1",
+		Resource:  "CoreVcnResource",
+		Operation: "Create",
+		Result:    "SUCCEEDED",
+		Duration:  1500 * time.Millisecond,
+	}
+	testApiCall = ApiCall{
+		Time:         time.Date(2019, 8, 20, 10, 0, 29, 0, time.UTC),
+		Tenancy:      "ocid1.tenancy.oc1..aaaa",
+		Region:       "us-phoenix-1",
+		Service:      "iaas",
+		Operation:    "POST /20160918/vcns",
+		StatusCode:   429,
+		Attempt:      2,
+		OpcRequestId: "ABCD/1234",
+		Latency:      250 * time.Millisecond,
+	}
+)
+
+func writeTestMetrics(t *testing.T, format string) string {
+	dir, err := ioutil.TempDir("", "metrics")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	path := filepath.Join(dir, "nested", "metrics")
+
+	sink, err := NewSink(format, path)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := sink.WriteResourceDuration(testResourceDuration); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := sink.WriteApiCall(testApiCall); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := sink.WriteApiCall(testApiCall); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	Flush()
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	os.RemoveAll(dir)
+	return string(content)
+}
+
+func TestUnitNewSink_unsupportedFormat(t *testing.T) {
+	if _, err := NewSink("csv", "metrics.csv"); err == nil {
+		t.Errorf("Expected an error for an unsupported format")
+	}
+}
+
+func TestUnitPipeSink(t *testing.T) {
+	expected := "2019-08-20T10:00:30Z|resourceDuration|tenancy:ocid1.tenancy.oc1..aaaa;region:us-phoenix-1;resource:CoreVcnResource;operation:Create;result:SUCCEEDED|1500\n" +
+		"2019-08-20T10:00:29Z|apiCallLatency|tenancy:ocid1.tenancy.oc1..aaaa;region:us-phoenix-1;service:iaas;operation:POST /20160918/vcns;status:429;attempt:2;opcRequestId:ABCD/1234|250\n"
+	if actual := writeTestMetrics(t, FormatPipe); actual != expected+expected[strings.Index(expected, "\n")+1:] {
+		t.Errorf("Unexpected metrics:\n%s", actual)
+	}
+}
+
+func TestUnitJsonLinesSink(t *testing.T) {
+	lines := strings.Split(strings.TrimSpace(writeTestMetrics(t, FormatJsonLines)), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("Expected 3 lines, got %d", len(lines))
+	}
+
+	var resourceDuration resourceDurationRecord
+	if err := json.Unmarshal([]byte(lines[0]), &resourceDuration); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if resourceDuration.Type != "resource_duration" || resourceDuration.Resource != "CoreVcnResource" || resourceDuration.DurationMs != 1500 {
+		t.Errorf("Unexpected resource duration: %s", lines[0])
+	}
+
+	var apiCall apiCallRecord
+	if err := json.Unmarshal([]byte(lines[1]), &apiCall); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if apiCall.Type != "api_call" || apiCall.Service != "iaas" || apiCall.StatusCode != 429 || apiCall.Attempt != 2 ||
+		apiCall.OpcRequestId != "ABCD/1234" || apiCall.LatencyMs != 250 || apiCall.Time != "2019-08-20T10:00:29Z" {
+		t.Errorf("Unexpected API call: %s", lines[1])
+	}
+}
+
+func TestUnitPrometheusSink(t *testing.T) {
+	actual := writeTestMetrics(t, FormatPrometheus)
+
+	expectedLines := []string{
+		"# TYPE oci_terraform_resource_operation_duration_seconds summary",
+		`oci_terraform_resource_operation_duration_seconds_sum{tenancy="ocid1.tenancy.oc1..aaaa",region="us-phoenix-1",resource="CoreVcnResource",operation="Create",result="SUCCEEDED"} 1.5`,
+		`oci_terraform_resource_operation_duration_seconds_count{tenancy="ocid1.tenancy.oc1..aaaa",region="us-phoenix-1",resource="CoreVcnResource",operation="Create",result="SUCCEEDED"} 1`,
+		`oci_terraform_api_call_latency_seconds_sum{tenancy="ocid1.tenancy.oc1..aaaa",region="us-phoenix-1",service="iaas",operation="POST /20160918/vcns"} 0.5`,
+		`oci_terraform_api_call_latency_seconds_count{tenancy="ocid1.tenancy.oc1..aaaa",region="us-phoenix-1",service="iaas",operation="POST /20160918/vcns"} 2`,
+		"# TYPE oci_terraform_api_calls_total counter",
+		`oci_terraform_api_calls_total{tenancy="ocid1.tenancy.oc1..aaaa",region="us-phoenix-1",service="iaas",operation="POST /20160918/vcns",status="429"} 2`,
+		`oci_terraform_api_call_retries_total{tenancy="ocid1.tenancy.oc1..aaaa",region="us-phoenix-1",service="iaas",operation="POST /20160918/vcns"} 2`,
+	}
+	for _, line := range expectedLines {
+		if !strings.Contains(actual, line+"\n") {
+			t.Errorf("Expected the metrics to contain %s, got:\n%s", line, actual)
+		}
+	}
+}
+
+func TestUnitPrometheusSink_flush(t *testing.T) {
+	dir, err := ioutil.TempDir("", "metrics")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "metrics.prom")
+
+	sink, _ := NewSink(FormatPrometheus, path)
+	if aliasSink, _ := NewSink(FormatPrometheus, path); aliasSink != sink {
+		t.Errorf("Expected the providers writing to the same file to share a sink")
+	}
+
+	defer func(interval time.Duration) { prometheusFlushInterval = interval }(prometheusFlushInterval)
+	prometheusFlushInterval = 50 * time.Millisecond
+
+	if err := sink.WriteApiCall(testApiCall); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("Expected the file to be written after the flush interval, got %v", err)
+	}
+
+	time.Sleep(10 * prometheusFlushInterval)
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.Contains(string(content), "oci_terraform_api_calls_total") {
+		t.Errorf("Unexpected metrics:\n%s", content)
+	}
+}
+
+func TestUnitPrometheusLabels_escaping(t *testing.T) {
+	if actual := prometheusLabels("operation", "GET \"a\\b\"\n"); actual != `operation="GET \"a\\b\"\n"` {
+		t.Errorf("Unexpected labels: %s", actual)
+	}
+}
+
+func TestUnitSpanSink(t *testing.T) {
+	lines := strings.Split(strings.TrimSpace(writeTestMetrics(t, FormatSpans)), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("Expected 3 lines, got %d", len(lines))
+	}
+
+	var resourceSpan, apiCallSpan, retrySpan span
+	for i, s := range []*span{&resourceSpan, &apiCallSpan, &retrySpan} {
+		if err := json.Unmarshal([]byte(lines[i]), s); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+
+	if resourceSpan.Name != "CoreVcnResource.Create" || resourceSpan.Kind != spanKindInternal || resourceSpan.Status.Code != spanStatusOk {
+		t.Errorf("Unexpected resource span: %s", lines[0])
+	}
+	if resourceSpan.StartTimeUnixNano != "1566295228500000000" || resourceSpan.EndTimeUnixNano != "1566295230000000000" {
+		t.Errorf("Expected the resource span to end when the operation ended, got %s", lines[0])
+	}
+	if len(resourceSpan.TraceId) != 32 || len(resourceSpan.SpanId) != 16 {
+		t.Errorf("Unexpected trace or span id: %s", lines[0])
+	}
+
+	if apiCallSpan.Name != "iaas POST /20160918/vcns" || apiCallSpan.Kind != spanKindClient || apiCallSpan.Status.Code != spanStatusError {
+		t.Errorf("Unexpected API call span: %s", lines[1])
+	}
+	if apiCallSpan.Attributes["oci.opc_request_id"] != "ABCD/1234" || apiCallSpan.Attributes["oci.retry_attempt"] != "2" || apiCallSpan.Attributes["http.status_code"] != "429" {
+		t.Errorf("Unexpected API call span attributes: %s", lines[1])
+	}
+
+	if apiCallSpan.TraceId != resourceSpan.TraceId || apiCallSpan.SpanId == retrySpan.SpanId {
+		t.Errorf("Expected the spans to share a trace and to have their own span id")
+	}
+}
+
+func TestUnitRecorder(t *testing.T) {
+	var disabled *Recorder
+	if disabled.ShouldWriteMetrics() {
+		t.Errorf("Expected a nil recorder not to write metrics")
+	}
+	disabled.SaveApiCallMetric(ApiCall{Service: "iaas"})
+
+	if NewRecorder("", "").ShouldWriteMetrics() != HasDefaultSinks() {
+		t.Errorf("Expected the default sinks to be used when no sink is given")
+	}
+
+	sink, aliasSink := &recordingSink{}, &recordingSink{}
+	recorder := NewRecorder("ocid1.tenancy.oc1..aaaa", "us-phoenix-1", sink)
+	aliasRecorder := NewRecorder("ocid1.tenancy.oc1..bbbb", "us-ashburn-1", aliasSink)
+	if !recorder.ShouldWriteMetrics() {
+		t.Errorf("Expected metrics to be written once a sink is given")
+	}
+
+	recorder.SaveResourceDurationMetric("CoreVcnResource", "Create", "SUCCEEDED", 1500)
+	recorder.SaveApiCallMetric(ApiCall{Service: "iaas", Region: "us-ashburn-1"})
+	recorder.SaveApiCallMetric(ApiCall{Service: "iaas"})
+	aliasRecorder.SaveApiCallMetric(ApiCall{Service: "iaas"})
+
+	if len(sink.resourceDurations) != 1 || sink.resourceDurations[0].Duration != 1500*time.Millisecond || sink.resourceDurations[0].Region != "us-phoenix-1" {
+		t.Errorf("Unexpected resource durations: %v", sink.resourceDurations)
+	}
+	if len(sink.apiCalls) != 2 || sink.apiCalls[0].Region != "us-ashburn-1" || sink.apiCalls[1].Region != "us-phoenix-1" || sink.apiCalls[1].Tenancy != "ocid1.tenancy.oc1..aaaa" {
+		t.Errorf("Expected the region of the call, or the region of the provider, got %v", sink.apiCalls)
+	}
+	if len(aliasSink.apiCalls) != 1 || aliasSink.apiCalls[0].Tenancy != "ocid1.tenancy.oc1..bbbb" {
+		t.Errorf("Expected every recorder to write to its own sinks, got %v", aliasSink.apiCalls)
+	}
+}
+
+type recordingSink struct {
+	resourceDurations []ResourceDuration
+	apiCalls          []ApiCall
+}
+
+func (s *recordingSink) WriteResourceDuration(metric ResourceDuration) error {
+	s.resourceDurations = append(s.resourceDurations, metric)
+	return nil
+}
+
+func (s *recordingSink) WriteApiCall(metric ApiCall) error {
+	s.apiCalls = append(s.apiCalls, metric)
+	return nil
+}
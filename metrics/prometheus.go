@@ -0,0 +1,211 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package metrics
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// prometheusFlushInterval is how long the file can lag behind the metrics, it is rewritten at most once per interval
+var prometheusFlushInterval = 10 * time.Second
+
+// prometheusSink keeps the totals of the metrics in memory and rewrites them to the file periodically and when the
+// provider exits, the file can be collected with e.g. the textfile collector of the node exporter
+type prometheusSink struct {
+	sync.Mutex
+	path string
+
+	// flushTimer is the pending rewrite of the file, it is nil when the file has all the metrics
+	flushTimer *time.Timer
+
+	resourceDurations summaries
+	apiCallLatencies  summaries
+	apiCalls          counters
+	apiCallRetries    counters
+}
+
+// summary is the count and sum of the observations of a series, in seconds
+type summary struct {
+	count int64
+	sum   float64
+}
+
+// series are keyed by their rendered labels
+type summaries map[string]*summary
+type counters map[string]int64
+
+func newPrometheusSink(path string) *prometheusSink {
+	return &prometheusSink{
+		path:              path,
+		resourceDurations: summaries{},
+		apiCallLatencies:  summaries{},
+		apiCalls:          counters{},
+		apiCallRetries:    counters{},
+	}
+}
+
+func (s *prometheusSink) WriteResourceDuration(metric ResourceDuration) error {
+	s.Lock()
+	defer s.Unlock()
+
+	s.resourceDurations.observe(prometheusLabels(
+		"tenancy", metric.Tenancy,
+		"region", metric.Region,
+		"resource", metric.Resource,
+		"operation", metric.Operation,
+		"result", metric.Result,
+	), metric.Duration.Seconds())
+
+	s.scheduleFlush()
+	return nil
+}
+
+func (s *prometheusSink) WriteApiCall(metric ApiCall) error {
+	s.Lock()
+	defer s.Unlock()
+
+	operationLabels := prometheusLabels(
+		"tenancy", metric.Tenancy,
+		"region", metric.Region,
+		"service", metric.Service,
+		"operation", metric.Operation,
+	)
+	s.apiCallLatencies.observe(operationLabels, metric.Latency.Seconds())
+	if metric.Attempt > 1 {
+		s.apiCallRetries[operationLabels]++
+	}
+	s.apiCalls[prometheusLabels(
+		"tenancy", metric.Tenancy,
+		"region", metric.Region,
+		"service", metric.Service,
+		"operation", metric.Operation,
+		"status", strconv.Itoa(metric.StatusCode),
+	)]++
+
+	s.scheduleFlush()
+	return nil
+}
+
+// scheduleFlush rewrites the file after the flush interval, the metrics written in the meantime are part of the same rewrite
+func (s *prometheusSink) scheduleFlush() {
+	if s.flushTimer != nil {
+		return
+	}
+	s.flushTimer = time.AfterFunc(prometheusFlushInterval, func() {
+		if err := s.Flush(); err != nil {
+			log.Printf("[WARN] metrics : save metrics got error: %s", err.Error())
+		}
+	})
+}
+
+// Flush rewrites the file now if it doesn't have all the metrics
+func (s *prometheusSink) Flush() error {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.flushTimer == nil {
+		return nil
+	}
+	s.flushTimer.Stop()
+	s.flushTimer = nil
+
+	return s.flush()
+}
+
+// flush writes the file to a temporary file that is renamed, so that collectors never read a partial file
+func (s *prometheusSink) flush() error {
+	var buffer bytes.Buffer
+
+	s.resourceDurations.write(&buffer, "oci_terraform_resource_operation_duration_seconds", "Duration of the operations on the resources, including the wait for their state.")
+	s.apiCallLatencies.write(&buffer, "oci_terraform_api_call_latency_seconds", "Latency of the calls to the OCI services.")
+	s.apiCalls.write(&buffer, "oci_terraform_api_calls_total", "Calls to the OCI services by response status, 0 when no response was received.")
+	s.apiCallRetries.write(&buffer, "oci_terraform_api_call_retries_total", "Calls to the OCI services that retried a previous call.")
+
+	if err := os.MkdirAll(filepath.Dir(s.path), 0755); err != nil {
+		return err
+	}
+	tmpFile, err := ioutil.TempFile(filepath.Dir(s.path), filepath.Base(s.path)+".tmp")
+	if err != nil {
+		return err
+	}
+	if _, err = tmpFile.Write(buffer.Bytes()); err != nil {
+		tmpFile.Close()
+		os.Remove(tmpFile.Name())
+		return err
+	}
+	if err = tmpFile.Close(); err != nil {
+		os.Remove(tmpFile.Name())
+		return err
+	}
+	if err = os.Chmod(tmpFile.Name(), 0644); err != nil {
+		os.Remove(tmpFile.Name())
+		return err
+	}
+	return os.Rename(tmpFile.Name(), s.path)
+}
+
+func (m summaries) observe(labels string, value float64) {
+	if _, ok := m[labels]; !ok {
+		m[labels] = &summary{}
+	}
+	m[labels].count++
+	m[labels].sum += value
+}
+
+func (m summaries) write(buffer *bytes.Buffer, name, help string) {
+	if len(m) == 0 {
+		return
+	}
+	fmt.Fprintf(buffer, "# HELP %s %s\n# TYPE %s summary\n", name, help, name)
+	for _, labels := range sortedKeys(m) {
+		fmt.Fprintf(buffer, "%s_sum{%s} %s\n", name, labels, strconv.FormatFloat(m[labels].sum, 'g', -1, 64))
+		fmt.Fprintf(buffer, "%s_count{%s} %d\n", name, labels, m[labels].count)
+	}
+}
+
+func (m counters) write(buffer *bytes.Buffer, name, help string) {
+	if len(m) == 0 {
+		return
+	}
+	fmt.Fprintf(buffer, "# HELP %s %s\n# TYPE %s counter\n", name, help, name)
+	for _, labels := range sortedKeys(m) {
+		fmt.Fprintf(buffer, "%s{%s} %d\n", name, labels, m[labels])
+	}
+}
+
+func sortedKeys(m interface{}) []string {
+	var keys []string
+	switch m := m.(type) {
+	case summaries:
+		for key := range m {
+			keys = append(keys, key)
+		}
+	case counters:
+		for key := range m {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+var prometheusLabelValueReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// prometheusLabels renders name/value pairs as name="value",...
+func prometheusLabels(nameValues ...string) string {
+	labels := make([]string, 0, len(nameValues)/2)
+	for i := 0; i+1 < len(nameValues); i += 2 {
+		labels = append(labels, fmt.Sprintf(`%s="%s"`, nameValues[i], prometheusLabelValueReplacer.Replace(nameValues[i+1])))
+	}
+	return strings.Join(labels, ",")
+}
@@ -0,0 +1,189 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package metrics
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+const (
+	// FormatPipe appends timestamp|metricName|dimensions|value rows, the format written with the `metrics` build tag
+	FormatPipe = "pipe"
+	// FormatJsonLines appends a JSON object per metric
+	FormatJsonLines = "jsonl"
+	// FormatPrometheus rewrites the file with the totals in the Prometheus text exposition format
+	FormatPrometheus = "prometheus"
+	// FormatSpans appends a JSON object per metric, shaped as an OpenTelemetry span
+	FormatSpans = "otel"
+)
+
+// Formats are the formats supported by NewSink
+var Formats = []string{FormatPipe, FormatJsonLines, FormatPrometheus, FormatSpans}
+
+var (
+	prometheusSinksLock sync.Mutex
+	// prometheusSinks are shared by the providers writing to the same file, so that the file has the totals of all of them
+	prometheusSinks = map[string]*prometheusSink{}
+)
+
+// NewSink returns a sink writing the metrics to the file at path in the given format
+func NewSink(format, path string) (Sink, error) {
+	switch format {
+	case FormatPipe:
+		return &pipeSink{file: appendFile{path: path}}, nil
+	case FormatJsonLines:
+		return &jsonLinesSink{file: appendFile{path: path}}, nil
+	case FormatPrometheus:
+		prometheusSinksLock.Lock()
+		defer prometheusSinksLock.Unlock()
+
+		if _, ok := prometheusSinks[path]; !ok {
+			prometheusSinks[path] = newPrometheusSink(path)
+		}
+		return prometheusSinks[path], nil
+	case FormatSpans:
+		return newSpanSink(path), nil
+	default:
+		return nil, fmt.Errorf("unsupported metrics format %s, supported formats are %v", format, Formats)
+	}
+}
+
+// Flush writes the metrics that the sinks keep in memory, it is called before the provider exits
+func Flush() {
+	prometheusSinksLock.Lock()
+	defer prometheusSinksLock.Unlock()
+
+	for _, sink := range prometheusSinks {
+		if err := sink.Flush(); err != nil {
+			log.Printf("[WARN] metrics : save metrics got error: %s", err.Error())
+		}
+	}
+}
+
+// appendFile appends lines to a file, creating it and its directory if they don't exist
+type appendFile struct {
+	sync.Mutex
+	path string
+}
+
+func (f *appendFile) appendLine(line []byte) error {
+	f.Lock()
+	defer f.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(f.path), 0755); err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(f.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+
+	if _, err = file.Write(append(line, '\n')); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+type pipeSink struct {
+	file appendFile
+}
+
+func (s *pipeSink) WriteResourceDuration(metric ResourceDuration) error {
+	return s.write(
+		metric.Time,
+		"resourceDuration",
+		fmt.Sprintf("tenancy:%s;region:%s;resource:%s;operation:%s;result:%s", metric.Tenancy, metric.Region, metric.Resource, metric.Operation, metric.Result),
+		milliseconds(metric.Duration),
+	)
+}
+
+func (s *pipeSink) WriteApiCall(metric ApiCall) error {
+	return s.write(
+		metric.Time,
+		"apiCallLatency",
+		fmt.Sprintf("tenancy:%s;region:%s;service:%s;operation:%s;status:%d;attempt:%d;opcRequestId:%s", metric.Tenancy, metric.Region, metric.Service, metric.Operation, metric.StatusCode, metric.Attempt, metric.OpcRequestId),
+		milliseconds(metric.Latency),
+	)
+}
+
+func (s *pipeSink) write(timestamp time.Time, metricName, dimensions string, value int64) error {
+	return s.file.appendLine([]byte(fmt.Sprintf("%s|%s|%s|%d", timestamp.UTC().Format(time.RFC3339), metricName, dimensions, value)))
+}
+
+type jsonLinesSink struct {
+	file appendFile
+}
+
+type resourceDurationRecord struct {
+	Type       string `json:"type"`
+	Time       string `json:"time"`
+	Tenancy    string `json:"tenancy"`
+	Region     string `json:"region"`
+	Resource   string `json:"resource"`
+	Operation  string `json:"operation"`
+	Result     string `json:"result"`
+	DurationMs int64  `json:"duration_ms"`
+}
+
+type apiCallRecord struct {
+	Type         string `json:"type"`
+	Time         string `json:"time"`
+	Tenancy      string `json:"tenancy"`
+	Region       string `json:"region"`
+	Service      string `json:"service"`
+	Operation    string `json:"operation"`
+	StatusCode   int    `json:"status_code"`
+	Attempt      uint   `json:"attempt"`
+	OpcRequestId string `json:"opc_request_id,omitempty"`
+	LatencyMs    int64  `json:"latency_ms"`
+	Error        string `json:"error,omitempty"`
+}
+
+func (s *jsonLinesSink) WriteResourceDuration(metric ResourceDuration) error {
+	return s.write(resourceDurationRecord{
+		Type:       "resource_duration",
+		Time:       metric.Time.UTC().Format(time.RFC3339Nano),
+		Tenancy:    metric.Tenancy,
+		Region:     metric.Region,
+		Resource:   metric.Resource,
+		Operation:  metric.Operation,
+		Result:     metric.Result,
+		DurationMs: milliseconds(metric.Duration),
+	})
+}
+
+func (s *jsonLinesSink) WriteApiCall(metric ApiCall) error {
+	return s.write(apiCallRecord{
+		Type:         "api_call",
+		Time:         metric.Time.UTC().Format(time.RFC3339Nano),
+		Tenancy:      metric.Tenancy,
+		Region:       metric.Region,
+		Service:      metric.Service,
+		Operation:    metric.Operation,
+		StatusCode:   metric.StatusCode,
+		Attempt:      metric.Attempt,
+		OpcRequestId: metric.OpcRequestId,
+		LatencyMs:    milliseconds(metric.Latency),
+		Error:        metric.Error,
+	})
+}
+
+func (s *jsonLinesSink) write(record interface{}) error {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	return s.file.appendLine(line)
+}
+
+func milliseconds(duration time.Duration) int64 {
+	return int64(duration / time.Millisecond)
+}
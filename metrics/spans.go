@@ -0,0 +1,104 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package metrics
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+const (
+	spanKindInternal = "SPAN_KIND_INTERNAL"
+	spanKindClient   = "SPAN_KIND_CLIENT"
+	spanStatusOk     = "STATUS_CODE_OK"
+	spanStatusError  = "STATUS_CODE_ERROR"
+)
+
+// spanSink writes the metrics as spans with the field names of the OpenTelemetry protocol JSON encoding,
+// all the spans written by a provider process share a trace
+type spanSink struct {
+	file    appendFile
+	traceId string
+}
+
+type span struct {
+	TraceId           string            `json:"traceId"`
+	SpanId            string            `json:"spanId"`
+	Name              string            `json:"name"`
+	Kind              string            `json:"kind"`
+	StartTimeUnixNano string            `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string            `json:"endTimeUnixNano"`
+	Attributes        map[string]string `json:"attributes"`
+	Status            spanStatus        `json:"status"`
+}
+
+type spanStatus struct {
+	Code    string `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+func newSpanSink(path string) *spanSink {
+	return &spanSink{file: appendFile{path: path}, traceId: randomHex(16)}
+}
+
+func (s *spanSink) WriteResourceDuration(metric ResourceDuration) error {
+	status := spanStatus{Code: spanStatusOk}
+	if metric.Result != "SUCCEEDED" {
+		status = spanStatus{Code: spanStatusError, Message: metric.Result}
+	}
+
+	return s.write(metric.Resource+"."+metric.Operation, spanKindInternal, metric.Time.Add(-metric.Duration), metric.Time, map[string]string{
+		"oci.tenancy":         metric.Tenancy,
+		"oci.region":          metric.Region,
+		"terraform.resource":  metric.Resource,
+		"terraform.operation": metric.Operation,
+		"terraform.result":    metric.Result,
+	}, status)
+}
+
+func (s *spanSink) WriteApiCall(metric ApiCall) error {
+	status := spanStatus{Code: spanStatusOk}
+	if metric.Error != "" {
+		status = spanStatus{Code: spanStatusError, Message: metric.Error}
+	} else if metric.StatusCode >= 400 {
+		status = spanStatus{Code: spanStatusError}
+	}
+
+	attributes := map[string]string{
+		"oci.tenancy":        metric.Tenancy,
+		"oci.region":         metric.Region,
+		"oci.service":        metric.Service,
+		"oci.operation":      metric.Operation,
+		"oci.retry_attempt":  strconv.FormatUint(uint64(metric.Attempt), 10),
+		"http.status_code":   strconv.Itoa(metric.StatusCode),
+		"oci.opc_request_id": metric.OpcRequestId,
+	}
+
+	return s.write(metric.Service+" "+metric.Operation, spanKindClient, metric.Time, metric.Time.Add(metric.Latency), attributes, status)
+}
+
+func (s *spanSink) write(name, kind string, start, end time.Time, attributes map[string]string, status spanStatus) error {
+	line, err := json.Marshal(span{
+		TraceId:           s.traceId,
+		SpanId:            randomHex(8),
+		Name:              name,
+		Kind:              kind,
+		StartTimeUnixNano: strconv.FormatInt(start.UnixNano(), 10),
+		EndTimeUnixNano:   strconv.FormatInt(end.UnixNano(), 10),
+		Attributes:        attributes,
+		Status:            status,
+	})
+	if err != nil {
+		return err
+	}
+	return s.file.appendLine(line)
+}
+
+func randomHex(bytes int) string {
+	id := make([]byte, bytes)
+	rand.Read(id)
+	return hex.EncodeToString(id)
+}
@@ -0,0 +1,165 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	oci_common "github.com/oracle/oci-go-sdk/common"
+
+	"github.com/terraform-providers/terraform-provider-oci/metrics"
+)
+
+// Path segments that identify a resource are replaced in the operation of an API call, so that all the calls
+// of an operation are aggregated together, e.g. GET /20160918/vcns/{id}
+var ocidPathSegmentRegex = regexp.MustCompile(`^ocid1\.`)
+
+// Object storage identifies namespaces, buckets, objects and multipart uploads by name after these segments
+var objectStorageNamedPathSegments = map[string]bool{"n": true, "b": true, "o": true, "p": true, "u": true}
+
+// apiCallClaimGracePeriod is how long a call waits for the retry policy of its operation to claim it with its attempt,
+// once the SDK has read the response. Calls of operations without a retry policy of the provider are written as attempt 1.
+var apiCallClaimGracePeriod = time.Second
+
+// pendingApiCalls are the calls that wait for their attempt, they are written when the provider exits
+var pendingApiCalls sync.Map
+
+// apiCallContextKey is the key of the pending metric of a call in the context of its request
+type apiCallContextKey struct{}
+
+// pendingApiCall is the metric of a call until the retry policy of its operation tells its attempt
+type pendingApiCall struct {
+	once     sync.Once
+	recorder *metrics.Recorder
+	metric   metrics.ApiCall
+}
+
+func (call *pendingApiCall) write(attempt uint) {
+	call.once.Do(func() {
+		pendingApiCalls.Delete(call)
+		call.metric.Attempt = attempt
+		call.recorder.SaveApiCallMetric(call.metric)
+	})
+}
+
+func (call *pendingApiCall) startGracePeriod() {
+	time.AfterFunc(apiCallClaimGracePeriod, func() {
+		call.write(1)
+	})
+}
+
+// apiCallBody starts the grace period of a call once the SDK is done with the response
+type apiCallBody struct {
+	io.ReadCloser
+	call *pendingApiCall
+}
+
+func (b apiCallBody) Close() error {
+	b.call.startGracePeriod()
+	return b.ReadCloser.Close()
+}
+
+// apiCallError is the error of a call without a response, it carries the call to the retry policy
+type apiCallError struct {
+	error
+	call *pendingApiCall
+}
+
+// apiCallMetricsDispatcher records every HTTP call of a client when metrics are enabled
+type apiCallMetricsDispatcher struct {
+	dispatcher oci_common.HTTPRequestDispatcher
+	recorder   *metrics.Recorder
+}
+
+func (d apiCallMetricsDispatcher) Do(r *http.Request) (*http.Response, error) {
+	call := &pendingApiCall{
+		recorder: d.recorder,
+		metric: metrics.ApiCall{
+			Region:    getRegionFromHost(r.URL.Hostname()),
+			Service:   getServiceFromHost(r.URL.Hostname()),
+			Operation: getApiCallOperation(r),
+		},
+	}
+	pendingApiCalls.Store(call, true)
+	// every attempt of an operation is a new request, its context carries the call to the retry policy
+	r = r.WithContext(context.WithValue(r.Context(), apiCallContextKey{}, call))
+
+	start := time.Now()
+	response, err := d.dispatcher.Do(r)
+	call.metric.Time = start.UTC()
+	call.metric.Latency = time.Since(start)
+
+	if response != nil {
+		call.metric.StatusCode = response.StatusCode
+		call.metric.OpcRequestId = response.Header.Get("opc-request-id")
+		if response.Request == nil {
+			response.Request = r
+		}
+	}
+	if err != nil {
+		call.metric.Error = err.Error()
+		call.startGracePeriod()
+		return response, apiCallError{err, call}
+	}
+
+	if response.Body != nil {
+		response.Body = apiCallBody{response.Body, call}
+	} else {
+		call.startGracePeriod()
+	}
+	return response, nil
+}
+
+// claimApiCall writes the call of an operation response with the attempt of the response, shouldRetry is asked by the
+// retry policies of the provider after every attempt
+func claimApiCall(response oci_common.OCIOperationResponse) {
+	var call *pendingApiCall
+	if callErr, ok := response.Error.(apiCallError); ok {
+		call = callErr.call
+	} else if response.Response != nil && response.Response.HTTPResponse() != nil && response.Response.HTTPResponse().Request != nil {
+		call, _ = response.Response.HTTPResponse().Request.Context().Value(apiCallContextKey{}).(*pendingApiCall)
+	}
+
+	if call != nil {
+		call.write(response.AttemptNumber)
+	}
+}
+
+// FlushMetrics writes the metrics that are still pending, it is called before the provider exits
+func FlushMetrics() {
+	pendingApiCalls.Range(func(call, _ interface{}) bool {
+		call.(*pendingApiCall).write(1)
+		return true
+	})
+	metrics.Flush()
+}
+
+// getApiCallOperation returns the method and the path of a call, without the OCIDs and object storage names
+func getApiCallOperation(r *http.Request) string {
+	segments := strings.Split(r.URL.EscapedPath(), "/")
+	namedSegments := getServiceFromHost(r.URL.Hostname()) == "objectstorage"
+	for i, segment := range segments {
+		if ocidPathSegmentRegex.MatchString(segment) {
+			segments[i] = "{id}"
+		} else if namedSegments && i > 0 && objectStorageNamedPathSegments[segments[i-1]] && segment != "" {
+			segments[i] = "{name}"
+		}
+	}
+	return r.Method + " " + strings.Join(segments, "/")
+}
+
+// getRegionFromHost returns the region of an endpoint, or an empty string if the endpoint has none
+func getRegionFromHost(host string) string {
+	for _, label := range strings.Split(strings.ToLower(host), ".") {
+		if hostRegionLabelRegex.MatchString(label) {
+			return label
+		}
+	}
+	return ""
+}
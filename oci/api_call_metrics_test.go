@@ -0,0 +1,290 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	oci_common "github.com/oracle/oci-go-sdk/common"
+
+	"github.com/terraform-providers/terraform-provider-oci/metrics"
+)
+
+type recordingMetricsSink struct {
+	sync.Mutex
+	resourceDurations []metrics.ResourceDuration
+	apiCalls          []metrics.ApiCall
+}
+
+func (s *recordingMetricsSink) WriteResourceDuration(metric metrics.ResourceDuration) error {
+	s.Lock()
+	defer s.Unlock()
+	s.resourceDurations = append(s.resourceDurations, metric)
+	return nil
+}
+
+func (s *recordingMetricsSink) WriteApiCall(metric metrics.ApiCall) error {
+	s.Lock()
+	defer s.Unlock()
+	s.apiCalls = append(s.apiCalls, metric)
+	return nil
+}
+
+type testHttpDispatcher struct {
+	statusCode int
+}
+
+func (d testHttpDispatcher) Do(r *http.Request) (*http.Response, error) {
+	if d.statusCode == 0 {
+		return nil, fmt.Errorf("connection refused")
+	}
+	header := http.Header{}
+	header.Set("opc-request-id", "ABCD/1234")
+	return &http.Response{StatusCode: d.statusCode, Header: header, Request: r}, nil
+}
+
+func TestUnitApiCallMetricsDispatcher(t *testing.T) {
+	sink := &recordingMetricsSink{}
+	recorder := metrics.NewRecorder("ocid1.tenancy.oc1..aaaa", "us-phoenix-1", sink)
+	request, _ := http.NewRequest(http.MethodPost, "https://iaas.us-ashburn-1.oraclecloud.com/20160918/vcns", nil)
+
+	// The SDK asks the retry policy after every attempt of an operation
+	retryPolicy := getRetryPolicy(false, "core")
+	response, err := apiCallMetricsDispatcher{testHttpDispatcher{statusCode: 429}, recorder}.Do(request)
+	if err != nil || response.StatusCode != 429 {
+		t.Fatalf("Expected the response of the dispatcher, got %v, %v", response, err)
+	}
+	retryPolicy.ShouldRetryOperation(oci_common.NewOCIOperationResponse(responseWithRequest{response}, fmt.Errorf("Retriable error"), 1))
+	response, _ = apiCallMetricsDispatcher{testHttpDispatcher{statusCode: 200}, recorder}.Do(request)
+	retryPolicy.ShouldRetryOperation(oci_common.NewOCIOperationResponse(responseWithRequest{response}, nil, 2))
+
+	// Calls without a response are recorded with their error
+	_, err = apiCallMetricsDispatcher{testHttpDispatcher{}, recorder}.Do(request)
+	if err == nil || err.Error() != "connection refused" {
+		t.Errorf("Expected the error of the dispatcher, got %v", err)
+	}
+	getRetryPolicy(false, "core").ShouldRetryOperation(oci_common.NewOCIOperationResponse(responseWithRequest{}, err, 1))
+
+	if len(sink.apiCalls) != 3 {
+		t.Fatalf("Expected 3 API calls, got %v", sink.apiCalls)
+	}
+
+	throttled := sink.apiCalls[0]
+	if throttled.Service != "iaas" || throttled.Operation != "POST /20160918/vcns" || throttled.Region != "us-ashburn-1" || throttled.Tenancy != "ocid1.tenancy.oc1..aaaa" {
+		t.Errorf("Unexpected API call: %v", throttled)
+	}
+	if throttled.StatusCode != 429 || throttled.OpcRequestId != "ABCD/1234" || throttled.Attempt != 1 || throttled.Latency < 0 {
+		t.Errorf("Unexpected API call: %v", throttled)
+	}
+
+	if retried := sink.apiCalls[1]; retried.StatusCode != 200 || retried.Attempt != 2 {
+		t.Errorf("Expected the retried call to be attempt 2, got %v", retried)
+	}
+
+	if failed := sink.apiCalls[2]; failed.StatusCode != 0 || failed.Error != "connection refused" || failed.Attempt != 1 {
+		t.Errorf("Unexpected API call: %v", failed)
+	}
+}
+
+func TestUnitApiCallMetricsDispatcher_concurrentCalls(t *testing.T) {
+	sink := &recordingMetricsSink{}
+	recorder := metrics.NewRecorder("ocid1.tenancy.oc1..aaaa", "us-phoenix-1", sink)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			request, _ := http.NewRequest(http.MethodGet, "https://iaas.us-ashburn-1.oraclecloud.com/20160918/vcns", nil)
+			retryPolicy := getRetryPolicy(false, "core")
+			for attempt, statusCode := range []int{429, 200} {
+				response, _ := apiCallMetricsDispatcher{testHttpDispatcher{statusCode: statusCode}, recorder}.Do(request)
+				retryPolicy.ShouldRetryOperation(oci_common.NewOCIOperationResponse(responseWithRequest{response}, nil, uint(attempt+1)))
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(sink.apiCalls) != 20 {
+		t.Fatalf("Expected 20 API calls, got %d", len(sink.apiCalls))
+	}
+	for _, apiCall := range sink.apiCalls {
+		if apiCall.StatusCode == 200 && apiCall.Attempt != 2 || apiCall.StatusCode == 429 && apiCall.Attempt != 1 {
+			t.Errorf("Expected every call to have the attempt of its own operation, got %v", apiCall)
+		}
+	}
+}
+
+func TestUnitApiCallMetricsDispatcher_withoutRetryPolicy(t *testing.T) {
+	defer func(gracePeriod time.Duration) { apiCallClaimGracePeriod = gracePeriod }(apiCallClaimGracePeriod)
+	apiCallClaimGracePeriod = 10 * time.Millisecond
+
+	sink := &recordingMetricsSink{}
+	recorder := metrics.NewRecorder("ocid1.tenancy.oc1..aaaa", "us-phoenix-1", sink)
+	request, _ := http.NewRequest(http.MethodGet, "https://identity.us-phoenix-1.oraclecloud.com/20160918/regions", nil)
+
+	apiCallMetricsDispatcher{testHttpDispatcher{statusCode: 200}, recorder}.Do(request)
+	time.Sleep(10 * apiCallClaimGracePeriod)
+
+	sink.Lock()
+	defer sink.Unlock()
+	if len(sink.apiCalls) != 1 || sink.apiCalls[0].Attempt != 1 {
+		t.Errorf("Expected the call to be recorded as attempt 1 once no retry policy claims it, got %v", sink.apiCalls)
+	}
+}
+
+func TestUnitApiCallMetricsDispatcher_retryPolicies(t *testing.T) {
+	retryPolicies := map[string]func() *oci_common.RetryPolicy{
+		"additional retry condition": func() *oci_common.RetryPolicy {
+			return getRetryPolicyWithAdditionalRetryCondition(time.Minute, func(oci_common.OCIOperationResponse) bool { return false }, "core")
+		},
+		"db system termination": func() *oci_common.RetryPolicy { return waitForDbSystemToTerminateRetryPolicy(time.Minute) },
+		"kms":                   func() *oci_common.RetryPolicy { return getRetryPolicy(false, kmsService) },
+	}
+
+	for name, getPolicy := range retryPolicies {
+		sink := &recordingMetricsSink{}
+		recorder := metrics.NewRecorder("ocid1.tenancy.oc1..aaaa", "us-phoenix-1", sink)
+		request, _ := http.NewRequest(http.MethodGet, "https://iaas.us-ashburn-1.oraclecloud.com/20160918/vcns", nil)
+
+		retryPolicy := getPolicy()
+		for attempt, statusCode := range []int{429, 200} {
+			response, _ := apiCallMetricsDispatcher{testHttpDispatcher{statusCode: statusCode}, recorder}.Do(request)
+			retryPolicy.ShouldRetryOperation(oci_common.NewOCIOperationResponse(responseWithRequest{response}, nil, uint(attempt+1)))
+		}
+
+		if len(sink.apiCalls) != 2 || sink.apiCalls[0].Attempt != 1 || sink.apiCalls[1].Attempt != 2 {
+			t.Errorf("Expected the calls of the %s retry policy to be recorded with their attempt, got %v", name, sink.apiCalls)
+		}
+	}
+}
+
+func TestUnitAddResourcesDurationMetrics(t *testing.T) {
+	sink := &recordingMetricsSink{}
+	clients := &OracleClients{metricsRecorder: metrics.NewRecorder("ocid1.tenancy.oc1..aaaa", "us-phoenix-1", sink)}
+
+	resources := addResourcesDurationMetrics(map[string]*schema.Resource{
+		"oci_core_vcn": {
+			Create: func(d *schema.ResourceData, m interface{}) error { return nil },
+			Delete: func(d *schema.ResourceData, m interface{}) error { return fmt.Errorf("Conflict") },
+		},
+	})
+	resource := resources["oci_core_vcn"]
+
+	if err := resource.Create(resource.Data(nil), clients); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if err := resource.Delete(resource.Data(nil), clients); err == nil || err.Error() != "Conflict" {
+		t.Errorf("Expected the error of the delete, got %v", err)
+	}
+	if err := resource.Create(resource.Data(nil), &OracleClients{}); err != nil {
+		t.Errorf("Unexpected error without metrics: %v", err)
+	}
+
+	if len(sink.resourceDurations) != 2 {
+		t.Fatalf("Expected 2 resource durations, got %v", sink.resourceDurations)
+	}
+	if created := sink.resourceDurations[0]; created.Resource != "oci_core_vcn" || created.Operation != "Create" || created.Result != SUCCEEDED {
+		t.Errorf("Unexpected resource duration: %v", created)
+	}
+	if deleted := sink.resourceDurations[1]; deleted.Resource != "oci_core_vcn" || deleted.Operation != "Delete" || deleted.Result != FAILED {
+		t.Errorf("Unexpected resource duration: %v", deleted)
+	}
+}
+
+// responseWithRequest is the response of an operation, with the HTTP response that was dispatched
+type responseWithRequest struct {
+	response *http.Response
+}
+
+func (r responseWithRequest) HTTPResponse() *http.Response {
+	return r.response
+}
+
+func TestUnitGetApiCallOperation(t *testing.T) {
+	type testCase struct {
+		method            string
+		url               string
+		expectedOperation string
+	}
+	testCases := []testCase{
+		{http.MethodGet, "https://iaas.us-phoenix-1.oraclecloud.com/20160918/vcns?compartmentId=ocid1.compartment.oc1..aaaa", "GET /20160918/vcns"},
+		{http.MethodGet, "https://iaas.us-phoenix-1.oraclecloud.com/20160918/vcns/ocid1.vcn.oc1.phx.aaaa", "GET /20160918/vcns/{id}"},
+		{http.MethodPost, "https://database.us-phoenix-1.oraclecloud.com/20160918/dbSystems/ocid1.dbsystem.oc1.phx.aaaa/actions/migrate", "POST /20160918/dbSystems/{id}/actions/migrate"},
+		{http.MethodPut, "https://objectstorage.us-phoenix-1.oraclecloud.com/n/tenancy/b/bucket/o/dir%2Ffile.txt", "PUT /n/{name}/b/{name}/o/{name}"},
+		{http.MethodGet, "https://objectstorage.us-phoenix-1.oraclecloud.com/n/tenancy/b/o/o", "GET /n/{name}/b/{name}/o"},
+	}
+
+	for _, test := range testCases {
+		request, _ := http.NewRequest(test.method, test.url, nil)
+		if actual := getApiCallOperation(request); actual != test.expectedOperation {
+			t.Errorf("Expected operation %s for %s, got %s", test.expectedOperation, test.url, actual)
+		}
+	}
+}
+
+func TestUnitGetRegionFromHost(t *testing.T) {
+	if actual := getRegionFromHost("xxxxx-management.kms.us-ashburn-1.oraclecloud.com"); actual != "us-ashburn-1" {
+		t.Errorf("Expected us-ashburn-1, got %s", actual)
+	}
+	if actual := getRegionFromHost("localhost"); actual != "" {
+		t.Errorf("Expected no region, got %s", actual)
+	}
+}
+
+func TestUnitNewMetricsRecorder(t *testing.T) {
+	dir, err := ioutil.TempDir("", "metrics")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	configProvider := oci_common.NewRawConfigurationProvider(testTenancyOCID, testUserOCID, "us-phoenix-1", testKeyFingerPrint, testPrivateKey, nil)
+	path := filepath.Join(dir, "metrics.jsonl")
+
+	r := &schema.Resource{Schema: schemaMap()}
+	d := r.Data(nil)
+	recorder, err := newMetricsRecorder(d, configProvider)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if recorder.ShouldWriteMetrics() != metrics.HasDefaultSinks() {
+		t.Errorf("Expected metrics to be disabled without sinks")
+	}
+
+	d.Set(metricsAttrName, []interface{}{
+		map[string]interface{}{metricsFormatAttrName: metrics.FormatJsonLines, metricsPathAttrName: path},
+	})
+	recorder, err = newMetricsRecorder(d, configProvider)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !recorder.ShouldWriteMetrics() {
+		t.Fatalf("Expected metrics to be enabled")
+	}
+
+	recorder.SaveApiCallMetric(metrics.ApiCall{Time: time.Now(), Service: "iaas", Operation: "GET /20160918/vcns", StatusCode: 200, Attempt: 1})
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.Contains(string(content), `"tenancy":"`+testTenancyOCID+`","region":"us-phoenix-1","service":"iaas"`) {
+		t.Errorf("Expected the API call with the tenancy and region of the provider, got %s", content)
+	}
+
+	d.Set(metricsAttrName, []interface{}{
+		map[string]interface{}{metricsFormatAttrName: "csv", metricsPathAttrName: path},
+	})
+	if _, err := newMetricsRecorder(d, configProvider); err == nil {
+		t.Errorf("Expected an error for an unsupported format")
+	}
+}
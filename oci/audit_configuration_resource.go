@@ -4,6 +4,8 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
@@ -13,6 +15,9 @@ import (
 
 func AuditConfigurationResource() *schema.Resource {
 	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: importAuditConfiguration,
+		},
 		Timeouts: DefaultTimeout,
 		Create:   createAuditConfiguration,
 		Read:     readAuditConfiguration,
@@ -137,3 +142,13 @@ func (s *AuditConfigurationResourceCrud) SetData() error {
 
 	return nil
 }
+
+func importAuditConfiguration(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	compartmentId := d.Id()
+	if !strings.HasPrefix(compartmentId, "ocid1.") {
+		return nil, fmt.Errorf("id %s should be of format: {compartmentId}", compartmentId)
+	}
+
+	err := d.Set("compartment_id", compartmentId)
+	return []*schema.ResourceData{d}, err
+}
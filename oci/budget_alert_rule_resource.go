@@ -4,6 +4,10 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
 
@@ -12,6 +16,9 @@ import (
 
 func BudgetAlertRuleResource() *schema.Resource {
 	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: importBudgetAlertRule,
+		},
 		Timeouts: DefaultTimeout,
 		Create:   createBudgetAlertRule,
 		Read:     readBudgetAlertRule,
@@ -376,3 +383,34 @@ func (s *BudgetAlertRuleResourceCrud) SetData() error {
 
 	return nil
 }
+
+func importBudgetAlertRule(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	budgetId, alertRuleId, err := parseAlertRuleCompositeId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(alertRuleId)
+	err = d.Set("budget_id", budgetId)
+	return []*schema.ResourceData{d}, err
+}
+
+func getAlertRuleCompositeId(budgetId string, alertRuleId string) string {
+	budgetId = url.PathEscape(budgetId)
+	alertRuleId = url.PathEscape(alertRuleId)
+	compositeId := "budgets/" + budgetId + "/alertRules/" + alertRuleId
+	return compositeId
+}
+
+func parseAlertRuleCompositeId(compositeId string) (budgetId string, alertRuleId string, err error) {
+	parts := strings.Split(compositeId, "/")
+	match, _ := regexp.MatchString("^budgets/[^/]+/alertRules/[^/]+$", compositeId)
+	if !match || len(parts) != 4 {
+		err = fmt.Errorf("illegal compositeId %s encountered, expected format: budgets/{budgetId}/alertRules/{alertRuleId}", compositeId)
+		return
+	}
+	budgetId, _ = url.PathUnescape(parts[1])
+	alertRuleId, _ = url.PathUnescape(parts[3])
+
+	return
+}
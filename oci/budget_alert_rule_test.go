@@ -209,10 +209,34 @@ func TestBudgetAlertRuleResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(singularDatasourceName, "version"),
 				),
 			},
+			// remove singular datasource from previous step so that it doesn't conflict with import tests
+			{
+				Config: config + compartmentIdVariableStr + AlertRuleResourceConfig,
+			},
+			// verify resource import
+			{
+				Config:                  config,
+				ImportStateIdFunc:       getBudgetAlertRuleImportId(resourceName),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{},
+				ResourceName:            resourceName,
+			},
 		},
 	})
 }
 
+func getBudgetAlertRuleImportId(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return getAlertRuleCompositeId(rs.Primary.Attributes["budget_id"], rs.Primary.ID), nil
+	}
+}
+
 func testAccCheckBudgetAlertRuleDestroy(s *terraform.State) error {
 	noResourceFound := true
 	client := testAccProvider.Meta().(*OracleClients).budgetClient
@@ -4,6 +4,10 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
@@ -12,6 +16,9 @@ import (
 
 func AppCatalogListingResourceVersionAgreementResource() *schema.Resource {
 	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: importAppCatalogListingResourceVersionAgreement,
+		},
 		Timeouts: DefaultTimeout,
 		Create:   createAppCatalogListingResourceVersionAgreement,
 		Read:     readAppCatalogListingResourceVersionAgreement,
@@ -147,3 +154,37 @@ func (s *AppCatalogListingResourceVersionAgreementResourceCrud) SetData() error
 
 	return nil
 }
+
+// The agreement is not stored by the service and retrieving one signs a new agreement, so importing only sets the arguments
+func importAppCatalogListingResourceVersionAgreement(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	listingId, listingResourceVersion, err := parseListingResourceVersionAgreementCompositeId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("listing_id", listingId); err != nil {
+		return nil, err
+	}
+	err = d.Set("listing_resource_version", listingResourceVersion)
+	return []*schema.ResourceData{d}, err
+}
+
+func getListingResourceVersionAgreementCompositeId(listingId string, listingResourceVersion string) string {
+	listingId = url.PathEscape(listingId)
+	listingResourceVersion = url.PathEscape(listingResourceVersion)
+	compositeId := "listings/" + listingId + "/resourceVersions/" + listingResourceVersion
+	return compositeId
+}
+
+func parseListingResourceVersionAgreementCompositeId(compositeId string) (listingId string, listingResourceVersion string, err error) {
+	parts := strings.Split(compositeId, "/")
+	match, _ := regexp.MatchString("^listings/[^/]+/resourceVersions/[^/]+$", compositeId)
+	if !match || len(parts) != 4 {
+		err = fmt.Errorf("illegal compositeId %s encountered, expected format: listings/{listingId}/resourceVersions/{listingResourceVersion}", compositeId)
+		return
+	}
+	listingId, _ = url.PathUnescape(parts[1])
+	listingResourceVersion, _ = url.PathUnescape(parts[3])
+
+	return
+}
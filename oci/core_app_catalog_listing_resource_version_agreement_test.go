@@ -58,6 +58,36 @@ func TestCoreAppCatalogListingResourceVersionAgreementResource_basic(t *testing.
 					resource.TestMatchResourceAttr(resourceName, "time_retrieved", RCF3339NanoReg),
 				),
 			},
+			// verify resource import, which doesn't retrieve a new agreement
+			{
+				Config:            config + compartmentIdVariableStr + AppCatalogListingResourceVersionAgreementResourceConfig,
+				ImportStateIdFunc: getListingResourceVersionAgreementImportId(resourceName),
+				ImportState:       true,
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 {
+						return fmt.Errorf("expected one imported agreement, found %d", len(states))
+					}
+					if states[0].Attributes["listing_id"] == "" || states[0].Attributes["listing_resource_version"] == "" {
+						return fmt.Errorf("imported agreement has no listing resource version")
+					}
+					if states[0].Attributes["signature"] != "" {
+						return fmt.Errorf("expected the imported agreement not to be signed, got %s", states[0].Attributes["signature"])
+					}
+					return nil
+				},
+				ResourceName: resourceName,
+			},
 		},
 	})
 }
+
+func getListingResourceVersionAgreementImportId(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return getListingResourceVersionAgreementCompositeId(rs.Primary.Attributes["listing_id"], rs.Primary.Attributes["listing_resource_version"]), nil
+	}
+}
@@ -0,0 +1,218 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	oci_core "github.com/oracle/oci-go-sdk/core"
+)
+
+func CoreBootVolumeAttachmentResource() *schema.Resource {
+	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: DefaultTimeout,
+		Create:   createCoreBootVolumeAttachment,
+		Read:     readCoreBootVolumeAttachment,
+		Delete:   deleteCoreBootVolumeAttachment,
+		Schema: map[string]*schema.Schema{
+			// Required
+			"boot_volume_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// Optional
+			"display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			// Computed
+			"availability_domain": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"compartment_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_pv_encryption_in_transit_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"time_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func createCoreBootVolumeAttachment(d *schema.ResourceData, m interface{}) error {
+	sync := &CoreBootVolumeAttachmentResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).computeClient
+
+	return CreateResource(d, sync)
+}
+
+func readCoreBootVolumeAttachment(d *schema.ResourceData, m interface{}) error {
+	sync := &CoreBootVolumeAttachmentResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).computeClient
+
+	return ReadResource(sync)
+}
+
+func deleteCoreBootVolumeAttachment(d *schema.ResourceData, m interface{}) error {
+	sync := &CoreBootVolumeAttachmentResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).computeClient
+	sync.DisableNotFoundRetries = true
+
+	return DeleteResource(d, sync)
+}
+
+type CoreBootVolumeAttachmentResourceCrud struct {
+	BaseCrud
+	Client                 *oci_core.ComputeClient
+	Res                    *oci_core.BootVolumeAttachment
+	DisableNotFoundRetries bool
+}
+
+func (s *CoreBootVolumeAttachmentResourceCrud) ID() string {
+	return *s.Res.Id
+}
+
+func (s *CoreBootVolumeAttachmentResourceCrud) CreatedPending() []string {
+	return []string{
+		string(oci_core.BootVolumeAttachmentLifecycleStateAttaching),
+	}
+}
+
+func (s *CoreBootVolumeAttachmentResourceCrud) CreatedTarget() []string {
+	return []string{
+		string(oci_core.BootVolumeAttachmentLifecycleStateAttached),
+	}
+}
+
+func (s *CoreBootVolumeAttachmentResourceCrud) DeletedPending() []string {
+	return []string{
+		string(oci_core.BootVolumeAttachmentLifecycleStateDetaching),
+	}
+}
+
+func (s *CoreBootVolumeAttachmentResourceCrud) DeletedTarget() []string {
+	return []string{
+		string(oci_core.BootVolumeAttachmentLifecycleStateDetached),
+	}
+}
+
+func (s *CoreBootVolumeAttachmentResourceCrud) Create() error {
+	request := oci_core.AttachBootVolumeRequest{}
+
+	if bootVolumeId, ok := s.D.GetOkExists("boot_volume_id"); ok {
+		tmp := bootVolumeId.(string)
+		request.BootVolumeId = &tmp
+	}
+
+	if displayName, ok := s.D.GetOkExists("display_name"); ok {
+		tmp := displayName.(string)
+		request.DisplayName = &tmp
+	}
+
+	if instanceId, ok := s.D.GetOkExists("instance_id"); ok {
+		tmp := instanceId.(string)
+		request.InstanceId = &tmp
+	}
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	response, err := s.Client.AttachBootVolume(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.BootVolumeAttachment
+	return nil
+}
+
+func (s *CoreBootVolumeAttachmentResourceCrud) Get() error {
+	request := oci_core.GetBootVolumeAttachmentRequest{}
+
+	tmp := s.D.Id()
+	request.BootVolumeAttachmentId = &tmp
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	response, err := s.Client.GetBootVolumeAttachment(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.BootVolumeAttachment
+	return nil
+}
+
+func (s *CoreBootVolumeAttachmentResourceCrud) Delete() error {
+	request := oci_core.DetachBootVolumeRequest{}
+
+	tmp := s.D.Id()
+	request.BootVolumeAttachmentId = &tmp
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	_, err := s.Client.DetachBootVolume(context.Background(), request)
+	return err
+}
+
+func (s *CoreBootVolumeAttachmentResourceCrud) SetData() error {
+	if s.Res.AvailabilityDomain != nil {
+		s.D.Set("availability_domain", *s.Res.AvailabilityDomain)
+	}
+
+	if s.Res.BootVolumeId != nil {
+		s.D.Set("boot_volume_id", *s.Res.BootVolumeId)
+	}
+
+	if s.Res.CompartmentId != nil {
+		s.D.Set("compartment_id", *s.Res.CompartmentId)
+	}
+
+	if s.Res.DisplayName != nil {
+		s.D.Set("display_name", *s.Res.DisplayName)
+	}
+
+	if s.Res.InstanceId != nil {
+		s.D.Set("instance_id", *s.Res.InstanceId)
+	}
+
+	if s.Res.IsPvEncryptionInTransitEnabled != nil {
+		s.D.Set("is_pv_encryption_in_transit_enabled", *s.Res.IsPvEncryptionInTransitEnabled)
+	}
+
+	s.D.Set("state", s.Res.LifecycleState)
+
+	if s.Res.TimeCreated != nil {
+		s.D.Set("time_created", s.Res.TimeCreated.String())
+	}
+
+	return nil
+}
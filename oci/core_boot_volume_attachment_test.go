@@ -3,12 +3,16 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/oracle/oci-go-sdk/common"
+	oci_core "github.com/oracle/oci-go-sdk/core"
 
 	"github.com/terraform-providers/terraform-provider-oci/httpreplay"
 )
@@ -21,9 +25,76 @@ var (
 		"instance_id":         Representation{repType: Optional, create: `${oci_core_instance.test_instance.id}`},
 	}
 
+	bootVolumeAttachmentRepresentation = map[string]interface{}{
+		"boot_volume_id": Representation{repType: Required, create: `${oci_core_instance.test_instance.boot_volume_id}`},
+		"instance_id":    Representation{repType: Required, create: `${oci_core_instance.test_instance.id}`},
+		"display_name":   Representation{repType: Optional, create: `displayName`},
+	}
+
 	BootVolumeAttachmentResourceConfig = BootVolumeResourceConfig
+
+	// The boot volume can only be detached and attached while the instance is stopped
+	BootVolumeAttachmentResourceDependencies = InstanceResourceDependencies +
+		generateResourceFromRepresentationMap("oci_core_instance", "test_instance", Required, Create, representationCopyWithNewProperties(instanceRepresentation, map[string]interface{}{
+			"preserve_boot_volume": Representation{repType: Required, create: `false`},
+			"state":                Representation{repType: Required, create: `STOPPED`},
+		}))
 )
 
+func TestCoreBootVolumeAttachmentResource_reattach(t *testing.T) {
+	httpreplay.SetScenario("TestCoreBootVolumeAttachmentResource_reattach")
+	defer httpreplay.SaveScenario()
+
+	provider := testAccProvider
+	config := testProviderConfig()
+
+	compartmentId := getEnvSettingWithBlankDefault("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	resourceName := "oci_core_boot_volume_attachment.test_boot_volume_attachment"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Providers: map[string]terraform.ResourceProvider{
+			"oci": provider,
+		},
+		CheckDestroy: testAccCheckCoreBootVolumeAttachmentDestroy,
+		Steps: []resource.TestStep{
+			// detach the boot volume the instance was launched with, as is done before repairing it from a rescue instance
+			{
+				Config: config + compartmentIdVariableStr + BootVolumeAttachmentResourceDependencies,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("oci_core_instance.test_instance", "state", string(oci_core.InstanceLifecycleStateStopped)),
+					resource.TestCheckResourceAttrSet("oci_core_instance.test_instance", "boot_volume_id"),
+					detachInstanceBootVolume("oci_core_instance.test_instance"),
+				),
+			},
+			// verify reattaching the boot volume
+			{
+				Config: config + compartmentIdVariableStr + BootVolumeAttachmentResourceDependencies +
+					generateResourceFromRepresentationMap("oci_core_boot_volume_attachment", "test_boot_volume_attachment", Optional, Create, bootVolumeAttachmentRepresentation),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "availability_domain"),
+					TestCheckResourceAttributesEqual(resourceName, "boot_volume_id", "oci_core_instance.test_instance", "boot_volume_id"),
+					resource.TestCheckResourceAttr(resourceName, "compartment_id", compartmentId),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "displayName"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					TestCheckResourceAttributesEqual(resourceName, "instance_id", "oci_core_instance.test_instance", "id"),
+					resource.TestCheckResourceAttr(resourceName, "state", string(oci_core.BootVolumeAttachmentLifecycleStateAttached)),
+					resource.TestCheckResourceAttrSet(resourceName, "time_created"),
+				),
+			},
+			// verify resource import
+			{
+				Config:            config + compartmentIdVariableStr + BootVolumeAttachmentResourceDependencies + generateResourceFromRepresentationMap("oci_core_boot_volume_attachment", "test_boot_volume_attachment", Optional, Create, bootVolumeAttachmentRepresentation),
+				ImportState:       true,
+				ImportStateVerify: true,
+				ResourceName:      resourceName,
+			},
+		},
+	})
+}
+
 func TestCoreBootVolumeAttachmentResource_basic(t *testing.T) {
 	httpreplay.SetScenario("TestCoreBootVolumeAttachmentResource_basic")
 	defer httpreplay.SaveScenario()
@@ -76,3 +147,97 @@ func TestCoreBootVolumeAttachmentResource_basic(t *testing.T) {
 		},
 	})
 }
+
+// detachInstanceBootVolume detaches the boot volume attachment created when the instance was launched, which is not
+// managed by any resource in the configuration
+func detachInstanceBootVolume(instanceResourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[instanceResourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", instanceResourceName)
+		}
+
+		client := testAccProvider.Meta().(*OracleClients).computeClient
+		instanceId := rs.Primary.ID
+		availabilityDomain := rs.Primary.Attributes["availability_domain"]
+		compartmentId := rs.Primary.Attributes["compartment_id"]
+		response, err := client.ListBootVolumeAttachments(context.Background(), oci_core.ListBootVolumeAttachmentsRequest{
+			AvailabilityDomain: &availabilityDomain,
+			CompartmentId:      &compartmentId,
+			InstanceId:         &instanceId,
+		})
+		if err != nil {
+			return err
+		}
+
+		attachment := findActiveBootVolumeAttachment(response.Items)
+		if attachment == nil {
+			return fmt.Errorf("no boot volume is attached to instance %s", instanceId)
+		}
+
+		if _, err := client.DetachBootVolume(context.Background(), oci_core.DetachBootVolumeRequest{BootVolumeAttachmentId: attachment.Id}); err != nil {
+			return err
+		}
+
+		waitTillCondition(testAccProvider, attachment.Id, bootVolumeAttachmentDetachWaitCondition, time.Duration(10*time.Minute),
+			bootVolumeAttachmentResponseFetchOperation, "core", true)()
+		return nil
+	}
+}
+
+func bootVolumeAttachmentDetachWaitCondition(response common.OCIOperationResponse) bool {
+	if bootVolumeAttachmentResponse, ok := response.Response.(oci_core.GetBootVolumeAttachmentResponse); ok {
+		return bootVolumeAttachmentResponse.LifecycleState != oci_core.BootVolumeAttachmentLifecycleStateDetached
+	}
+	return false
+}
+
+func bootVolumeAttachmentResponseFetchOperation(client *OracleClients, resourceId *string, retryPolicy *common.RetryPolicy) error {
+	_, err := client.computeClient.GetBootVolumeAttachment(context.Background(), oci_core.GetBootVolumeAttachmentRequest{
+		BootVolumeAttachmentId: resourceId,
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: retryPolicy,
+		},
+	})
+	return err
+}
+
+func testAccCheckCoreBootVolumeAttachmentDestroy(s *terraform.State) error {
+	noResourceFound := true
+	client := testAccProvider.Meta().(*OracleClients).computeClient
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type == "oci_core_boot_volume_attachment" {
+			noResourceFound = false
+			request := oci_core.GetBootVolumeAttachmentRequest{}
+
+			tmp := rs.Primary.ID
+			request.BootVolumeAttachmentId = &tmp
+
+			request.RequestMetadata.RetryPolicy = getRetryPolicy(true, "core")
+
+			response, err := client.GetBootVolumeAttachment(context.Background(), request)
+
+			if err == nil {
+				deletedLifecycleStates := map[string]bool{
+					string(oci_core.BootVolumeAttachmentLifecycleStateDetached): true,
+				}
+				if _, ok := deletedLifecycleStates[string(response.LifecycleState)]; !ok {
+					//resource lifecycle state is not in expected deleted lifecycle states.
+					return fmt.Errorf("resource lifecycle state: %s is not in expected deleted lifecycle states", response.LifecycleState)
+				}
+				//resource lifecycle state is in expected deleted lifecycle states. continue with next one.
+				continue
+			}
+
+			//Verify that exception is for '404 not found'.
+			if failure, isServiceError := common.IsServiceError(err); !isServiceError || failure.GetHTTPStatusCode() != 404 {
+				return err
+			}
+		}
+	}
+	if noResourceFound {
+		return fmt.Errorf("at least one resource was expected from the state file, but could not be found")
+	}
+
+	return nil
+}
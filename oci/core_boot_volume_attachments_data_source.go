@@ -33,55 +33,7 @@ func CoreBootVolumeAttachmentsDataSource() *schema.Resource {
 			"boot_volume_attachments": {
 				Type:     schema.TypeList,
 				Computed: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						// Required
-						"boot_volume_id": {
-							Type:     schema.TypeString,
-							Required: true,
-							ForceNew: true,
-						},
-						"instance_id": {
-							Type:     schema.TypeString,
-							Required: true,
-							ForceNew: true,
-						},
-
-						// Optional
-						"display_name": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Computed: true,
-							ForceNew: true,
-						},
-
-						// Computed
-						"availability_domain": {
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-						"compartment_id": {
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-						"id": {
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-						"is_pv_encryption_in_transit_enabled": {
-							Type:     schema.TypeBool,
-							Computed: true,
-						},
-						"state": {
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-						"time_created": {
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-					},
-				},
+				Elem:     GetDataSourceItemSchema(CoreBootVolumeAttachmentResource()),
 			},
 		},
 	}
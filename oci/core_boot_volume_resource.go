@@ -20,11 +20,12 @@ func CoreBootVolumeResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: DefaultTimeout,
-		Create:   createCoreBootVolume,
-		Read:     readCoreBootVolume,
-		Update:   updateCoreBootVolume,
-		Delete:   deleteCoreBootVolume,
+		Timeouts:      DefaultTimeout,
+		Create:        createCoreBootVolume,
+		Read:          readCoreBootVolume,
+		Update:        updateCoreBootVolume,
+		Delete:        deleteCoreBootVolume,
+		CustomizeDiff: volumeKmsKeyCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"availability_domain": {
@@ -100,6 +101,15 @@ func CoreBootVolumeResource() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"kms_key_management_endpoint": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"remove_kms_key": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"kms_key_id"},
+			},
 			"size_in_gbs": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -117,6 +127,10 @@ func CoreBootVolumeResource() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"kms_key_version_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"size_in_mbs": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -141,6 +155,7 @@ func createCoreBootVolume(d *schema.ResourceData, m interface{}) error {
 	sync := &CoreBootVolumeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*OracleClients).blockstorageClient
+	sync.Clients = m.(*OracleClients)
 
 	return CreateResource(d, sync)
 }
@@ -157,6 +172,7 @@ func updateCoreBootVolume(d *schema.ResourceData, m interface{}) error {
 	sync := &CoreBootVolumeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*OracleClients).blockstorageClient
+	sync.Clients = m.(*OracleClients)
 
 	return UpdateResource(d, sync)
 }
@@ -173,6 +189,7 @@ func deleteCoreBootVolume(d *schema.ResourceData, m interface{}) error {
 type CoreBootVolumeResourceCrud struct {
 	BaseCrud
 	Client                 *oci_core.BlockstorageClient
+	Clients                *OracleClients
 	Res                    *oci_core.BootVolume
 	DisableNotFoundRetries bool
 }
@@ -286,6 +303,11 @@ func (s *CoreBootVolumeResourceCrud) Create() error {
 	}
 
 	s.Res = &response.BootVolume
+
+	// The boot volume exists at this point, failing to read the key version only means the key is assigned again on the next apply
+	if err := setVolumeKmsKeyVersionId(s.D, s.Clients); err != nil {
+		log.Printf("[WARN] Unable to read the current version of the KMS key of boot volume %s: %v", *s.Res.Id, err)
+	}
 	return nil
 }
 
@@ -303,6 +325,16 @@ func (s *CoreBootVolumeResourceCrud) Get() error {
 	}
 
 	s.Res = &response.BootVolume
+
+	// The key is read from its dedicated endpoint, so that a key updated or removed outside of Terraform is detected
+	if s.Res.LifecycleState == oci_core.BootVolumeLifecycleStateAvailable {
+		kmsKeyId, err := s.getKmsKeyId()
+		if err != nil {
+			return err
+		}
+		s.Res.KmsKeyId = kmsKeyId
+	}
+
 	return nil
 }
 
@@ -338,19 +370,8 @@ func (s *CoreBootVolumeResourceCrud) Update() error {
 		request.FreeformTags = objectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
-	if s.D.HasChange("kms_key_id") {
-		keyUpdateRequest := oci_core.UpdateBootVolumeKmsKeyRequest{}
-
-		bootVolumeId := s.D.Id()
-		keyUpdateRequest.BootVolumeId = &bootVolumeId
-
-		tmp := s.D.Get("kms_key_id").(string)
-		keyUpdateRequest.KmsKeyId = &tmp
-
-		keyUpdateRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
-
-		_, err := s.Client.UpdateBootVolumeKmsKey(context.Background(), keyUpdateRequest)
-		if err != nil {
+	if s.D.HasChange("kms_key_id") || s.D.HasChange("kms_key_version_id") {
+		if err := s.updateKmsKey(); err != nil {
 			return err
 		}
 	}
@@ -387,6 +408,51 @@ func (s *CoreBootVolumeResourceCrud) Delete() error {
 	return err
 }
 
+func (s *CoreBootVolumeResourceCrud) getKmsKeyId() (*string, error) {
+	request := oci_core.GetBootVolumeKmsKeyRequest{}
+
+	bootVolumeId := s.D.Id()
+	request.BootVolumeId = &bootVolumeId
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	response, err := s.Client.GetBootVolumeKmsKey(context.Background(), request)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.KmsKeyId, nil
+}
+
+// updateKmsKey assigns the key to the boot volume, which is done again when the key was rotated so that the boot volume uses the
+// new key version. Removing the key reverts the boot volume to Oracle-managed keys.
+func (s *CoreBootVolumeResourceCrud) updateKmsKey() error {
+	bootVolumeId := s.D.Id()
+
+	kmsKeyId := s.D.Get("kms_key_id").(string)
+	if kmsKeyId == "" {
+		request := oci_core.DeleteBootVolumeKmsKeyRequest{}
+		request.BootVolumeId = &bootVolumeId
+
+		request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+
+		_, err := s.Client.DeleteBootVolumeKmsKey(context.Background(), request)
+		return err
+	}
+
+	request := oci_core.UpdateBootVolumeKmsKeyRequest{}
+	request.BootVolumeId = &bootVolumeId
+	request.KmsKeyId = &kmsKeyId
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	if _, err := s.Client.UpdateBootVolumeKmsKey(context.Background(), request); err != nil {
+		return err
+	}
+
+	return setVolumeKmsKeyVersionId(s.D, s.Clients)
+}
+
 func (s *CoreBootVolumeResourceCrud) SetData() error {
 	if s.Res.AvailabilityDomain != nil {
 		s.D.Set("availability_domain", *s.Res.AvailabilityDomain)
@@ -416,6 +482,8 @@ func (s *CoreBootVolumeResourceCrud) SetData() error {
 
 	if s.Res.KmsKeyId != nil {
 		s.D.Set("kms_key_id", *s.Res.KmsKeyId)
+	} else {
+		s.D.Set("kms_key_id", "")
 	}
 
 	if s.Res.SizeInGBs != nil {
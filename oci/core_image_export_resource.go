@@ -0,0 +1,213 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	oci_core "github.com/oracle/oci-go-sdk/core"
+)
+
+func CoreImageExportResource() *schema.Resource {
+	return &schema.Resource{
+		Timeouts: &schema.ResourceTimeout{
+			Create: &TwoHours,
+			Delete: &FifteenMinutes,
+		},
+		Create: createCoreImageExport,
+		Read:   readCoreImageExport,
+		Delete: deleteCoreImageExport,
+		Schema: map[string]*schema.Schema{
+			// Required
+			"destination_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: EqualIgnoreCaseSuppressDiff,
+				ValidateFunc: validation.StringInSlice([]string{
+					"objectStorageTuple",
+					"objectStorageUri",
+				}, true),
+			},
+			"image_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// Optional
+			"bucket_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"destination_uri": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"namespace_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"object_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			// Computed
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func createCoreImageExport(d *schema.ResourceData, m interface{}) error {
+	sync := &CoreImageExportResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).computeClient
+	sync.ObjectStorageEndpoint = m.(*OracleClients).objectStorageClient.Host
+
+	return CreateResource(d, sync)
+}
+
+func readCoreImageExport(d *schema.ResourceData, m interface{}) error {
+	sync := &CoreImageExportResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).computeClient
+
+	return ReadResource(sync)
+}
+
+// The exported object is not owned by this resource, delete it with Object Storage if it is no longer needed
+func deleteCoreImageExport(d *schema.ResourceData, m interface{}) error {
+	return nil
+}
+
+type CoreImageExportResourceCrud struct {
+	BaseCrud
+	Client                 *oci_core.ComputeClient
+	ObjectStorageEndpoint  string
+	Res                    *oci_core.Image
+	DisableNotFoundRetries bool
+}
+
+func (s *CoreImageExportResourceCrud) ID() string {
+	return getImageExportCompositeId(s.D.Get("image_id").(string), s.D.Get("destination_uri").(string))
+}
+
+func (s *CoreImageExportResourceCrud) CreatedPending() []string {
+	return []string{
+		string(oci_core.ImageLifecycleStateExporting),
+	}
+}
+
+func (s *CoreImageExportResourceCrud) CreatedTarget() []string {
+	return []string{
+		string(oci_core.ImageLifecycleStateAvailable),
+	}
+}
+
+func (s *CoreImageExportResourceCrud) Create() error {
+	request := oci_core.ExportImageRequest{}
+
+	imageId := s.D.Get("image_id").(string)
+	request.ImageId = &imageId
+
+	exportImageDetails, destinationUri, err := s.mapToExportImageDetails()
+	if err != nil {
+		return err
+	}
+	request.ExportImageDetails = exportImageDetails
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	response, err := s.Client.ExportImage(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.D.Set("destination_uri", destinationUri)
+
+	s.Res = &response.Image
+	return nil
+}
+
+func (s *CoreImageExportResourceCrud) Get() error {
+	request := oci_core.GetImageRequest{}
+
+	imageId := s.D.Get("image_id").(string)
+	request.ImageId = &imageId
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	response, err := s.Client.GetImage(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.Image
+	return nil
+}
+
+func (s *CoreImageExportResourceCrud) SetData() error {
+	s.D.Set("state", s.Res.LifecycleState)
+
+	return nil
+}
+
+// mapToExportImageDetails returns the export destination together with its URI, which for an Object Storage tuple is
+// the URI of the object in the region of the provider
+func (s *CoreImageExportResourceCrud) mapToExportImageDetails() (oci_core.ExportImageDetails, string, error) {
+	destinationType := s.D.Get("destination_type").(string)
+	switch strings.ToLower(destinationType) {
+	case strings.ToLower("objectStorageTuple"):
+		details := oci_core.ExportImageViaObjectStorageTupleDetails{}
+		bucketName, bucketOk := s.D.GetOkExists("bucket_name")
+		namespaceName, namespaceOk := s.D.GetOkExists("namespace_name")
+		objectName, objectOk := s.D.GetOkExists("object_name")
+		if !bucketOk || !namespaceOk || !objectOk {
+			return nil, "", fmt.Errorf("bucket_name, namespace_name and object_name are required when destination_type is %s", destinationType)
+		}
+
+		bucketNameStr := bucketName.(string)
+		details.BucketName = &bucketNameStr
+		namespaceNameStr := namespaceName.(string)
+		details.NamespaceName = &namespaceNameStr
+		objectNameStr := objectName.(string)
+		details.ObjectName = &objectNameStr
+
+		destinationUri := fmt.Sprintf("%s/n/%s/b/%s/o/%s", s.ObjectStorageEndpoint, url.PathEscape(namespaceNameStr), url.PathEscape(bucketNameStr), url.PathEscape(objectNameStr))
+		return details, destinationUri, nil
+	case strings.ToLower("objectStorageUri"):
+		details := oci_core.ExportImageViaObjectStorageUriDetails{}
+		destinationUri, ok := s.D.GetOkExists("destination_uri")
+		if !ok {
+			return nil, "", fmt.Errorf("destination_uri is required when destination_type is %s", destinationType)
+		}
+
+		tmp := destinationUri.(string)
+		details.DestinationUri = &tmp
+		return details, tmp, nil
+	default:
+		return nil, "", fmt.Errorf("unknown destination_type '%v' was specified", destinationType)
+	}
+}
+
+func getImageExportCompositeId(imageId string, destinationUri string) string {
+	imageId = url.PathEscape(imageId)
+	destinationUri = url.PathEscape(destinationUri)
+	compositeId := "images/" + imageId + "/exports/" + destinationUri
+	return compositeId
+}
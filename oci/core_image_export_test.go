@@ -0,0 +1,95 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/terraform-providers/terraform-provider-oci/httpreplay"
+)
+
+var (
+	imageExportRepresentation = map[string]interface{}{
+		"destination_type": Representation{repType: Required, create: `objectStorageTuple`},
+		"image_id":         Representation{repType: Required, create: `${oci_core_image.test_image.id}`},
+		"bucket_name":      Representation{repType: Required, create: `${oci_objectstorage_bucket.test_bucket.name}`},
+		"namespace_name":   Representation{repType: Required, create: `${data.oci_objectstorage_namespace.t.namespace}`},
+		"object_name":      Representation{repType: Required, create: `exported-image`},
+	}
+
+	imageExportUriRepresentation = map[string]interface{}{
+		"destination_type": Representation{repType: Required, create: `objectStorageUri`},
+		"image_id":         Representation{repType: Required, create: `${oci_core_image.test_image.id}`},
+		"destination_uri":  Representation{repType: Required, create: `https://objectstorage.${var.region}.oraclecloud.com${oci_objectstorage_preauthrequest.test_preauthenticated_request.access_uri}`},
+	}
+
+	imageExportPreauthenticatedRequestRepresentation = map[string]interface{}{
+		"access_type":  Representation{repType: Required, create: `ObjectWrite`},
+		"bucket":       Representation{repType: Required, create: `${oci_objectstorage_bucket.test_bucket.name}`},
+		"name":         Representation{repType: Required, create: `exported-image-par`},
+		"namespace":    Representation{repType: Required, create: `${data.oci_objectstorage_namespace.t.namespace}`},
+		"object":       Representation{repType: Required, create: `exported-image-par`},
+		"time_expires": Representation{repType: Required, create: `2030-01-01T00:00:00Z`},
+	}
+
+	ImageExportResourceDependencies = ImageRequiredOnlyResource + `
+data "oci_objectstorage_namespace" "t" {
+}
+` +
+		generateResourceFromRepresentationMap("oci_objectstorage_bucket", "test_bucket", Required, Create, bucketRepresentation)
+)
+
+func TestCoreImageExportResource_basic(t *testing.T) {
+	httpreplay.SetScenario("TestCoreImageExportResource_basic")
+	defer httpreplay.SaveScenario()
+
+	provider := testAccProvider
+	config := testProviderConfig()
+
+	compartmentId := getEnvSettingWithBlankDefault("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	resourceName := "oci_core_image_export.test_image_export"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Providers: map[string]terraform.ResourceProvider{
+			"oci": provider,
+		},
+		Steps: []resource.TestStep{
+			// verify export to a bucket and object
+			{
+				Config: config + compartmentIdVariableStr + ImageExportResourceDependencies +
+					generateResourceFromRepresentationMap("oci_core_image_export", "test_image_export", Required, Create, imageExportRepresentation),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "image_id"),
+					resource.TestCheckResourceAttr(resourceName, "destination_type", "objectStorageTuple"),
+					resource.TestCheckResourceAttr(resourceName, "object_name", "exported-image"),
+					resource.TestCheckResourceAttrSet(resourceName, "destination_uri"),
+					resource.TestCheckResourceAttr(resourceName, "state", "AVAILABLE"),
+				),
+			},
+
+			// delete before next create
+			{
+				Config: config + compartmentIdVariableStr + ImageExportResourceDependencies,
+			},
+			// verify export to a pre-authenticated request URI
+			{
+				Config: config + compartmentIdVariableStr + ImageExportResourceDependencies +
+					generateResourceFromRepresentationMap("oci_objectstorage_preauthrequest", "test_preauthenticated_request", Required, Create, imageExportPreauthenticatedRequestRepresentation) +
+					generateResourceFromRepresentationMap("oci_core_image_export", "test_image_export", Required, Create, imageExportUriRepresentation),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "image_id"),
+					resource.TestCheckResourceAttr(resourceName, "destination_type", "objectStorageUri"),
+					resource.TestCheckResourceAttrSet(resourceName, "destination_uri"),
+					resource.TestCheckResourceAttr(resourceName, "state", "AVAILABLE"),
+				),
+			},
+		},
+	})
+}
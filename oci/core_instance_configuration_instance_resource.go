@@ -0,0 +1,298 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	oci_core "github.com/oracle/oci-go-sdk/core"
+)
+
+func CoreInstanceConfigurationInstanceResource() *schema.Resource {
+	// The overrides accepted at launch are the same instance details that are stored in an instance configuration
+	instanceDetailsSchema := CoreInstanceConfigurationResource().Schema["instance_details"]
+	instanceDetailsSchema.Computed = false
+
+	return &schema.Resource{
+		Timeouts: DefaultTimeout,
+		Create:   createCoreInstanceConfigurationInstance,
+		Read:     readCoreInstanceConfigurationInstance,
+		Update:   updateCoreInstanceConfigurationInstance,
+		Delete:   deleteCoreInstanceConfigurationInstance,
+		Schema: map[string]*schema.Schema{
+			// Required
+			"instance_configuration_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// Optional
+			"instance_details": instanceDetailsSchema,
+			"preserve_boot_volume": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"state": {
+				Type:             schema.TypeString,
+				Computed:         true,
+				Optional:         true,
+				DiffSuppressFunc: EqualIgnoreCaseSuppressDiff,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_core.InstanceLifecycleStateStopped),
+					string(oci_core.InstanceLifecycleStateRunning),
+				}, true),
+			},
+
+			// Computed
+			"availability_domain": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"boot_volume_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"compartment_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"defined_tags": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     schema.TypeString,
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"fault_domain": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"freeform_tags": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     schema.TypeString,
+			},
+			"image": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"private_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"public_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"shape": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"time_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func createCoreInstanceConfigurationInstance(d *schema.ResourceData, m interface{}) error {
+	sync := &CoreInstanceConfigurationInstanceResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).computeClient
+	sync.VirtualNetworkClient = m.(*OracleClients).virtualNetworkClient
+	sync.BlockStorageClient = m.(*OracleClients).blockstorageClient
+	sync.ComputeManagementClient = m.(*OracleClients).computeManagementClient
+
+	var powerOff = false
+	if powerState, ok := sync.D.GetOkExists("state"); ok {
+		wantedPowerState := oci_core.InstanceLifecycleStateEnum(strings.ToUpper(powerState.(string)))
+		if wantedPowerState == oci_core.InstanceLifecycleStateStopped {
+			powerOff = true
+		}
+	}
+
+	if e := CreateResource(d, sync); e != nil {
+		return e
+	}
+
+	if powerOff {
+		if err := sync.InstanceAction(oci_core.InstanceActionActionStop, oci_core.InstanceLifecycleStateStopped); err != nil {
+			return err
+		}
+		return ReadResource(sync)
+	}
+	return nil
+}
+
+func readCoreInstanceConfigurationInstance(d *schema.ResourceData, m interface{}) error {
+	sync := &CoreInstanceConfigurationInstanceResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).computeClient
+	sync.VirtualNetworkClient = m.(*OracleClients).virtualNetworkClient
+	sync.BlockStorageClient = m.(*OracleClients).blockstorageClient
+	sync.ComputeManagementClient = m.(*OracleClients).computeManagementClient
+
+	return ReadResource(sync)
+}
+
+// Only the power state of the launched instance can be updated, every other argument forces a new instance
+func updateCoreInstanceConfigurationInstance(d *schema.ResourceData, m interface{}) error {
+	sync := &CoreInstanceConfigurationInstanceResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).computeClient
+	sync.VirtualNetworkClient = m.(*OracleClients).virtualNetworkClient
+	sync.BlockStorageClient = m.(*OracleClients).blockstorageClient
+	sync.ComputeManagementClient = m.(*OracleClients).computeManagementClient
+
+	if sync.D.HasChange("state") {
+		wantedState := oci_core.InstanceLifecycleStateEnum(strings.ToUpper(sync.D.Get("state").(string)))
+		switch wantedState {
+		case oci_core.InstanceLifecycleStateRunning:
+			if err := sync.InstanceAction(oci_core.InstanceActionActionStart, oci_core.InstanceLifecycleStateRunning); err != nil {
+				return err
+			}
+		case oci_core.InstanceLifecycleStateStopped:
+			if err := sync.InstanceAction(oci_core.InstanceActionActionStop, oci_core.InstanceLifecycleStateStopped); err != nil {
+				return err
+			}
+		}
+	}
+
+	return ReadResource(sync)
+}
+
+func deleteCoreInstanceConfigurationInstance(d *schema.ResourceData, m interface{}) error {
+	sync := &CoreInstanceConfigurationInstanceResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).computeClient
+	sync.VirtualNetworkClient = m.(*OracleClients).virtualNetworkClient
+	sync.BlockStorageClient = m.(*OracleClients).blockstorageClient
+	sync.ComputeManagementClient = m.(*OracleClients).computeManagementClient
+	sync.DisableNotFoundRetries = true
+
+	return DeleteResource(d, sync)
+}
+
+// CoreInstanceConfigurationInstanceResourceCrud launches the instance from an instance configuration and manages it
+// afterwards like an instance launched by oci_core_instance
+type CoreInstanceConfigurationInstanceResourceCrud struct {
+	CoreInstanceResourceCrud
+	ComputeManagementClient *oci_core.ComputeManagementClient
+}
+
+func (s *CoreInstanceConfigurationInstanceResourceCrud) Create() error {
+	request := oci_core.LaunchInstanceConfigurationRequest{}
+
+	instanceConfigurationId := s.D.Get("instance_configuration_id").(string)
+	request.InstanceConfigurationId = &instanceConfigurationId
+
+	// Without overrides the instance is launched exactly as described by the instance configuration
+	request.InstanceConfiguration = oci_core.ComputeInstanceDetails{}
+	if instanceDetails, ok := s.D.GetOkExists("instance_details"); ok {
+		if tmpList := instanceDetails.([]interface{}); len(tmpList) > 0 {
+			instanceConfigurationSync := &CoreInstanceConfigurationResourceCrud{}
+			instanceConfigurationSync.D = s.D
+
+			fieldKeyFormat := fmt.Sprintf("%s.%d.%%s", "instance_details", 0)
+			tmp, err := instanceConfigurationSync.mapToInstanceConfigurationInstanceDetails(fieldKeyFormat)
+			if err != nil {
+				return err
+			}
+			request.InstanceConfiguration = tmp
+		}
+	}
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	response, err := s.ComputeManagementClient.LaunchInstanceConfiguration(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.Instance
+	return nil
+}
+
+func (s *CoreInstanceConfigurationInstanceResourceCrud) SetData() error {
+	if s.Res.AvailabilityDomain != nil {
+		s.D.Set("availability_domain", *s.Res.AvailabilityDomain)
+	}
+
+	if s.Res.CompartmentId != nil {
+		s.D.Set("compartment_id", *s.Res.CompartmentId)
+	}
+
+	if s.Res.DefinedTags != nil {
+		s.D.Set("defined_tags", definedTagsToMap(s.Res.DefinedTags))
+	}
+
+	if s.Res.DisplayName != nil {
+		s.D.Set("display_name", *s.Res.DisplayName)
+	}
+
+	if s.Res.FaultDomain != nil {
+		s.D.Set("fault_domain", *s.Res.FaultDomain)
+	}
+
+	s.D.Set("freeform_tags", s.Res.FreeformTags)
+
+	if s.Res.ImageId != nil {
+		s.D.Set("image", *s.Res.ImageId)
+	}
+
+	if s.Res.Region != nil {
+		s.D.Set("region", *s.Res.Region)
+	}
+
+	if s.Res.Shape != nil {
+		s.D.Set("shape", *s.Res.Shape)
+	}
+
+	bootVolume, bootVolumeErr := s.getBootVolume()
+	if bootVolumeErr != nil {
+		log.Printf("[WARN] Could not get the boot volume: %q", bootVolumeErr)
+	}
+
+	if bootVolume != nil && bootVolume.Id != nil {
+		s.D.Set("boot_volume_id", *bootVolume.Id)
+	}
+
+	s.D.Set("state", s.Res.LifecycleState)
+
+	if s.Res.TimeCreated != nil {
+		s.D.Set("time_created", s.Res.TimeCreated.String())
+	}
+
+	if s.Res.LifecycleState != oci_core.InstanceLifecycleStateTerminated &&
+		s.Res.LifecycleState != oci_core.InstanceLifecycleStateProvisioning &&
+		s.Res.LifecycleState != oci_core.InstanceLifecycleStateTerminating {
+		vnic, vnicError := s.getPrimaryVnic()
+		if vnicError != nil || vnic == nil {
+			log.Printf("[WARN] Primary VNIC could not be found during instance refresh: %q", vnicError)
+		} else {
+			s.D.Set("public_ip", vnic.PublicIp)
+			s.D.Set("private_ip", vnic.PrivateIp)
+			s.D.Set("subnet_id", vnic.SubnetId)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/terraform-providers/terraform-provider-oci/httpreplay"
+)
+
+var (
+	instanceConfigurationInstanceRepresentation = map[string]interface{}{
+		"instance_configuration_id": Representation{repType: Required, create: `${oci_core_instance_configuration.test_instance_configuration.id}`},
+		"instance_details":          RepresentationGroup{Required, instanceConfigurationInstanceInstanceDetailsRepresentation},
+		"preserve_boot_volume":      Representation{repType: Optional, create: `false`},
+		"state":                     Representation{repType: Optional, create: `RUNNING`, update: `STOPPED`},
+	}
+	instanceConfigurationInstanceInstanceDetailsRepresentation = map[string]interface{}{
+		"instance_type":  Representation{repType: Required, create: `compute`},
+		"launch_details": RepresentationGroup{Required, instanceConfigurationInstanceLaunchDetailsRepresentation},
+	}
+	instanceConfigurationInstanceLaunchDetailsRepresentation = map[string]interface{}{
+		"availability_domain": Representation{repType: Required, create: `${data.oci_identity_availability_domains.test_availability_domains.availability_domains.0.name}`},
+		"compartment_id":      Representation{repType: Required, create: `${var.compartment_id}`},
+		"create_vnic_details": RepresentationGroup{Required, instanceConfigurationInstanceLaunchDetailsCreateVnicDetailsRepresentation},
+		"display_name":        Representation{repType: Required, create: `standalone-server`},
+	}
+	instanceConfigurationInstanceLaunchDetailsCreateVnicDetailsRepresentation = map[string]interface{}{
+		"subnet_id": Representation{repType: Required, create: `${oci_core_subnet.test_subnet.id}`},
+	}
+
+	InstanceConfigurationInstanceResourceDependencies = InstancePoolResourceDependencies
+)
+
+func TestCoreInstanceConfigurationInstanceResource_basic(t *testing.T) {
+	httpreplay.SetScenario("TestCoreInstanceConfigurationInstanceResource_basic")
+	defer httpreplay.SaveScenario()
+
+	provider := testAccProvider
+	config := testProviderConfig()
+
+	compartmentId := getEnvSettingWithBlankDefault("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	resourceName := "oci_core_instance_configuration_instance.test_instance_configuration_instance"
+
+	var resId, resId2 string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Providers: map[string]terraform.ResourceProvider{
+			"oci": provider,
+		},
+		Steps: []resource.TestStep{
+			// verify create
+			{
+				Config: config + compartmentIdVariableStr + InstanceConfigurationInstanceResourceDependencies +
+					generateResourceFromRepresentationMap("oci_core_instance_configuration_instance", "test_instance_configuration_instance", Optional, Create, instanceConfigurationInstanceRepresentation),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "availability_domain"),
+					resource.TestCheckResourceAttrSet(resourceName, "boot_volume_id"),
+					resource.TestCheckResourceAttr(resourceName, "compartment_id", compartmentId),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "standalone-server"),
+					resource.TestCheckResourceAttrSet(resourceName, "image"),
+					resource.TestCheckResourceAttrSet(resourceName, "instance_configuration_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "private_ip"),
+					resource.TestCheckResourceAttr(resourceName, "shape", InstanceConfigurationVmShape),
+					resource.TestCheckResourceAttr(resourceName, "state", "RUNNING"),
+					resource.TestCheckResourceAttrSet(resourceName, "subnet_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "time_created"),
+
+					func(s *terraform.State) (err error) {
+						resId, err = fromInstanceState(s, resourceName, "id")
+						return err
+					},
+				),
+			},
+
+			// verify stopping the instance
+			{
+				Config: config + compartmentIdVariableStr + InstanceConfigurationInstanceResourceDependencies +
+					generateResourceFromRepresentationMap("oci_core_instance_configuration_instance", "test_instance_configuration_instance", Optional, Update, instanceConfigurationInstanceRepresentation),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "state", "STOPPED"),
+
+					func(s *terraform.State) (err error) {
+						resId2, err = fromInstanceState(s, resourceName, "id")
+						if resId != resId2 {
+							return fmt.Errorf("Resource recreated when it was supposed to be updated.")
+						}
+						return err
+					},
+				),
+			},
+		},
+	})
+}
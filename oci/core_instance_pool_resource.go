@@ -17,6 +17,9 @@ import (
 const (
 	instancePoolRunningState = "running"
 	instancePoolStoppedState = "stopped"
+
+	instancePoolResetType     = "RESET"
+	instancePoolSoftresetType = "SOFTRESET"
 )
 
 func CoreInstancePoolResource() *schema.Resource {
@@ -111,30 +114,24 @@ func CoreInstancePoolResource() *schema.Resource {
 			"load_balancers": {
 				Type:     schema.TypeList,
 				Optional: true,
-				Computed: true,
-				ForceNew: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						// Required
 						"backend_set_name": {
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
 						},
 						"load_balancer_id": {
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
 						},
 						"port": {
 							Type:     schema.TypeInt,
 							Required: true,
-							ForceNew: true,
 						},
 						"vnic_selection": {
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
 						},
 
 						// Optional
@@ -156,6 +153,19 @@ func CoreInstancePoolResource() *schema.Resource {
 				},
 			},
 
+			"reset_trigger": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"reset_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					instancePoolResetType,
+					instancePoolSoftresetType,
+				}, false),
+			},
+
 			// Computed
 			"state": {
 				Type:             schema.TypeString,
@@ -407,6 +417,13 @@ func (s *CoreInstancePoolResourceCrud) Update() error {
 			}
 		}
 	}
+
+	if s.D.HasChange("load_balancers") {
+		if err := s.updateLoadBalancers(); err != nil {
+			return err
+		}
+	}
+
 	request := oci_core.UpdateInstancePoolRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -474,6 +491,132 @@ func (s *CoreInstancePoolResourceCrud) Update() error {
 
 	s.Res = instancePool
 
+	if instancePoolResetRequested(s.D) {
+		if err := s.resetInstancePool(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// updateLoadBalancers detaches the load balancers removed from the configuration and attaches the ones added to it,
+// waiting for each attachment to settle so that the pool is not modified while a previous change is in progress
+func (s *CoreInstancePoolResourceCrud) updateLoadBalancers() error {
+	oldRaw, newRaw := s.D.GetChange("load_balancers")
+	toDetach, toAttach := getInstancePoolLoadBalancerChanges(oldRaw.([]interface{}), newRaw.([]interface{}))
+
+	for _, loadBalancer := range toDetach {
+		if err := s.detachLoadBalancer(loadBalancer); err != nil {
+			return err
+		}
+	}
+
+	for _, loadBalancer := range toAttach {
+		if err := s.attachLoadBalancer(loadBalancer); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *CoreInstancePoolResourceCrud) attachLoadBalancer(details oci_core.AttachLoadBalancerDetails) error {
+	request := oci_core.AttachLoadBalancerRequest{}
+	request.AttachLoadBalancerDetails = details
+
+	tmp := s.D.Id()
+	request.InstancePoolId = &tmp
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	_, err := s.Client.AttachLoadBalancer(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	attachedFunc := func() bool {
+		attachment := findInstancePoolLoadBalancerAttachment(s.Res, details.LoadBalancerId, details.BackendSetName)
+		return attachment != nil && attachment.LifecycleState == oci_core.InstancePoolLoadBalancerAttachmentLifecycleStateAttached
+	}
+
+	return WaitForResourceCondition(s, attachedFunc, s.D.Timeout(schema.TimeoutUpdate))
+}
+
+func (s *CoreInstancePoolResourceCrud) detachLoadBalancer(details oci_core.AttachLoadBalancerDetails) error {
+	request := oci_core.DetachLoadBalancerRequest{}
+	request.LoadBalancerId = details.LoadBalancerId
+	request.BackendSetName = details.BackendSetName
+
+	tmp := s.D.Id()
+	request.InstancePoolId = &tmp
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	_, err := s.Client.DetachLoadBalancer(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	detachedFunc := func() bool {
+		attachment := findInstancePoolLoadBalancerAttachment(s.Res, details.LoadBalancerId, details.BackendSetName)
+		return attachment == nil || attachment.LifecycleState == oci_core.InstancePoolLoadBalancerAttachmentLifecycleStateDetached
+	}
+
+	return WaitForResourceCondition(s, detachedFunc, s.D.Timeout(schema.TimeoutUpdate))
+}
+
+// Removing the trigger does not reset the instances
+func instancePoolResetRequested(d *schema.ResourceData) bool {
+	trigger, _ := d.Get("reset_trigger").(string)
+	return trigger != "" && d.HasChange("reset_trigger")
+}
+
+// resetInstancePool waits for the pool to settle after the update and then power cycles its instances. A change to
+// reset_trigger is only a signal, its value is not sent to the service
+func (s *CoreInstancePoolResourceCrud) resetInstancePool() error {
+	settledFunc := func() bool {
+		return s.Res.LifecycleState == oci_core.InstancePoolLifecycleStateRunning ||
+			s.Res.LifecycleState == oci_core.InstancePoolLifecycleStateStopped
+	}
+	if err := WaitForResourceCondition(s, settledFunc, s.D.Timeout(schema.TimeoutUpdate)); err != nil {
+		return err
+	}
+
+	instancePoolId := s.D.Id()
+
+	resetType := instancePoolResetType
+	if value, ok := s.D.GetOkExists("reset_type"); ok {
+		resetType = value.(string)
+	}
+
+	switch resetType {
+	case instancePoolResetType:
+		request := oci_core.ResetInstancePoolRequest{}
+		request.InstancePoolId = &instancePoolId
+		request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+
+		response, err := s.Client.ResetInstancePool(context.Background(), request)
+		if err != nil {
+			return err
+		}
+
+		s.Res = &response.InstancePool
+	case instancePoolSoftresetType:
+		request := oci_core.SoftresetInstancePoolRequest{}
+		request.InstancePoolId = &instancePoolId
+		request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+
+		response, err := s.Client.SoftresetInstancePool(context.Background(), request)
+		if err != nil {
+			return err
+		}
+
+		s.Res = &response.InstancePool
+	default:
+		return fmt.Errorf("received unknown 'reset_type' %s", resetType)
+	}
+
 	return nil
 }
 
@@ -510,6 +653,10 @@ func (s *CoreInstancePoolResourceCrud) SetData() error {
 
 	loadBalancers := []interface{}{}
 	for _, item := range s.Res.LoadBalancers {
+		// Detached load balancers are kept in the pool's attachments, they are no longer part of the configuration
+		if item.LifecycleState == oci_core.InstancePoolLoadBalancerAttachmentLifecycleStateDetached {
+			continue
+		}
 		loadBalancers = append(loadBalancers, InstancePoolLoadBalancerAttachmentToMap(item))
 	}
 	s.D.Set("load_balancers", loadBalancers)
@@ -328,6 +328,31 @@ func TestCoreInstancePoolResource_basic(t *testing.T) {
 					},
 				),
 			},
+			// verify load balancer attachment changes are applied in place and the pool is reset
+			{
+				Config: config + compartmentIdVariableStr + InstancePoolResourceDependencies +
+					generateResourceFromRepresentationMap("oci_core_instance_pool", "test_instance_pool", Optional, Update,
+						representationCopyWithNewProperties(instancePoolRepresentation, map[string]interface{}{
+							"load_balancers": RepresentationGroup{Optional, getUpdatedRepresentationCopy("port", Representation{repType: Required, create: `11`}, instancePoolLoadBalancersRepresentation)},
+							"reset_trigger":  Representation{repType: Optional, create: `1`},
+						})),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "load_balancers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "load_balancers.0.port", "11"),
+					resource.TestCheckResourceAttr(resourceName, "load_balancers.0.state", "ATTACHED"),
+					resource.TestCheckResourceAttr(resourceName, "reset_trigger", "1"),
+					resource.TestCheckResourceAttr(resourceName, "size", "3"),
+					resource.TestCheckResourceAttr(resourceName, "state", "RUNNING"),
+
+					func(s *terraform.State) (err error) {
+						resId2, err = fromInstanceState(s, resourceName, "id")
+						if resId != resId2 {
+							return fmt.Errorf("Resource recreated when it was supposed to be updated.")
+						}
+						return err
+					},
+				),
+			},
 			// verify datasource the state will be updated to RUNNING
 			{
 				Config: config +
@@ -383,11 +408,13 @@ func TestCoreInstancePoolResource_basic(t *testing.T) {
 			},
 			// verify resource import
 			{
-				Config:                  config + compartmentIdVariableStr + InstancePoolResourceConfig,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{},
-				ResourceName:            resourceName,
+				Config:            config + compartmentIdVariableStr + InstancePoolResourceConfig,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"reset_type",
+				},
+				ResourceName: resourceName,
 			},
 		},
 	})
@@ -513,3 +540,32 @@ func instancePoolSweepResponseFetchOperation(client *OracleClients, resourceId *
 	})
 	return err
 }
+
+func TestUnitCoreInstancePoolResource_instancePoolResetRequested(t *testing.T) {
+	type testCase struct {
+		old      string
+		new      string
+		expected bool
+	}
+	testCases := []testCase{
+		{"1", "2", true},
+		{"", "1", true},
+		{"1", "1", false},
+		{"1", "", false},
+	}
+
+	for _, test := range testCases {
+		state := &terraform.InstanceState{ID: "ocid1.instancepool.oc1..aaaa", Attributes: map[string]string{"reset_trigger": test.old}}
+		diff := &terraform.InstanceDiff{Attributes: map[string]*terraform.ResourceAttrDiff{
+			"reset_trigger": {Old: test.old, New: test.new, NewRemoved: test.new == ""},
+		}}
+		d, err := schema.InternalMap(CoreInstancePoolResource().Schema).Data(state, diff)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if actual := instancePoolResetRequested(d); actual != test.expected {
+			t.Errorf("Expected a reset to be requested to be %v when the trigger changes from %q to %q, got %v", test.expected, test.old, test.new, actual)
+		}
+	}
+}
@@ -1259,11 +1259,12 @@ func (s *CoreInstanceResourceCrud) getBootVolume() (*oci_core.BootVolume, error)
 		return nil, err
 	}
 
-	if len(response.Items) < 1 {
+	attachment := findActiveBootVolumeAttachment(response.Items)
+	if attachment == nil {
 		return nil, fmt.Errorf("Could not find any attached boot volumes")
 	}
 
-	bootVolumeId := response.Items[0].BootVolumeId
+	bootVolumeId := attachment.BootVolumeId
 	if bootVolumeId == nil {
 		return nil, fmt.Errorf("Found a boot volume attachment with no boot volume ID")
 	}
@@ -5,6 +5,9 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/core"
@@ -12,6 +15,9 @@ import (
 
 func CoreIpSecConnectionTunnelManagementResource() *schema.Resource {
 	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: importCoreIpSecConnectionTunnelManagement,
+		},
 		Timeouts: DefaultTimeout,
 		Create:   createCoreIpSecConnectionTunnelManagement,
 		Read:     readCoreIpSecConnectionTunnelManagement,
@@ -357,3 +363,37 @@ func (s *CoreIpSecConnectionTunnelManagementResourceCrud) SetData() error {
 
 	return nil
 }
+
+func importCoreIpSecConnectionTunnelManagement(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	ipsecId, tunnelId, err := parseIpSecConnectionTunnelCompositeId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(tunnelId)
+	if err := d.Set("ipsec_id", ipsecId); err != nil {
+		return nil, err
+	}
+	err = d.Set("tunnel_id", tunnelId)
+	return []*schema.ResourceData{d}, err
+}
+
+func getIpSecConnectionTunnelCompositeId(ipsecId string, tunnelId string) string {
+	ipsecId = url.PathEscape(ipsecId)
+	tunnelId = url.PathEscape(tunnelId)
+	compositeId := "ipsecConnections/" + ipsecId + "/tunnels/" + tunnelId
+	return compositeId
+}
+
+func parseIpSecConnectionTunnelCompositeId(compositeId string) (ipsecId string, tunnelId string, err error) {
+	parts := strings.Split(compositeId, "/")
+	match, _ := regexp.MatchString("^ipsecConnections/[^/]+/tunnels/[^/]+$", compositeId)
+	if !match || len(parts) != 4 {
+		err = fmt.Errorf("illegal compositeId %s encountered, expected format: ipsecConnections/{ipsecId}/tunnels/{tunnelId}", compositeId)
+		return
+	}
+	ipsecId, _ = url.PathUnescape(parts[1])
+	tunnelId, _ = url.PathUnescape(parts[3])
+
+	return
+}
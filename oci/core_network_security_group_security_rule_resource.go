@@ -5,6 +5,9 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
 
@@ -13,6 +16,9 @@ import (
 
 func CoreNetworkSecurityGroupSecurityRuleResource() *schema.Resource {
 	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: importCoreNetworkSecurityGroupSecurityRule,
+		},
 		Timeouts: DefaultTimeout,
 		Create:   createCoreNetworkSecurityGroupSecurityRule,
 		Read:     readCoreNetworkSecurityGroupSecurityRule,
@@ -729,3 +735,34 @@ func nsgPortRangeToMap(obj *oci_core.PortRange) map[string]interface{} {
 
 	return result
 }
+
+func importCoreNetworkSecurityGroupSecurityRule(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	networkSecurityGroupId, securityRuleId, err := parseNetworkSecurityGroupSecurityRuleCompositeId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(securityRuleId)
+	err = d.Set("network_security_group_id", networkSecurityGroupId)
+	return []*schema.ResourceData{d}, err
+}
+
+func getNetworkSecurityGroupSecurityRuleCompositeId(networkSecurityGroupId string, securityRuleId string) string {
+	networkSecurityGroupId = url.PathEscape(networkSecurityGroupId)
+	securityRuleId = url.PathEscape(securityRuleId)
+	compositeId := "networkSecurityGroups/" + networkSecurityGroupId + "/securityRules/" + securityRuleId
+	return compositeId
+}
+
+func parseNetworkSecurityGroupSecurityRuleCompositeId(compositeId string) (networkSecurityGroupId string, securityRuleId string, err error) {
+	parts := strings.Split(compositeId, "/")
+	match, _ := regexp.MatchString("^networkSecurityGroups/[^/]+/securityRules/[^/]+$", compositeId)
+	if !match || len(parts) != 4 {
+		err = fmt.Errorf("illegal compositeId %s encountered, expected format: networkSecurityGroups/{networkSecurityGroupId}/securityRules/{securityRuleId}", compositeId)
+		return
+	}
+	networkSecurityGroupId, _ = url.PathUnescape(parts[1])
+	securityRuleId, _ = url.PathUnescape(parts[3])
+
+	return
+}
@@ -238,6 +238,26 @@ func TestCoreNetworkSecurityGroupSecurityRuleResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(datasourceName, "security_rules.0.udp_options.#", "1"),
 				),
 			},
+			// verify resource import
+			{
+				Config:                  config,
+				ImportStateIdFunc:       getNetworkSecurityGroupSecurityRuleImportId(resourceName),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{},
+				ResourceName:            resourceName,
+			},
 		},
 	})
 }
+
+func getNetworkSecurityGroupSecurityRuleImportId(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return getNetworkSecurityGroupSecurityRuleCompositeId(rs.Primary.Attributes["network_security_group_id"], rs.Primary.ID), nil
+	}
+}
@@ -20,11 +20,12 @@ func CoreVolumeResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: DefaultTimeout,
-		Create:   createCoreVolume,
-		Read:     readCoreVolume,
-		Update:   updateCoreVolume,
-		Delete:   deleteCoreVolume,
+		Timeouts:      DefaultTimeout,
+		Create:        createCoreVolume,
+		Read:          readCoreVolume,
+		Update:        updateCoreVolume,
+		Delete:        deleteCoreVolume,
+		CustomizeDiff: volumeKmsKeyCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"availability_domain": {
@@ -69,6 +70,15 @@ func CoreVolumeResource() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"kms_key_management_endpoint": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"remove_kms_key": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"kms_key_id"},
+			},
 			"size_in_gbs": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -129,6 +139,10 @@ func CoreVolumeResource() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"kms_key_version_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"state": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -149,6 +163,7 @@ func createCoreVolume(d *schema.ResourceData, m interface{}) error {
 	sync := &CoreVolumeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*OracleClients).blockstorageClient
+	sync.Clients = m.(*OracleClients)
 
 	return CreateResource(d, sync)
 }
@@ -165,6 +180,7 @@ func updateCoreVolume(d *schema.ResourceData, m interface{}) error {
 	sync := &CoreVolumeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*OracleClients).blockstorageClient
+	sync.Clients = m.(*OracleClients)
 
 	return UpdateResource(d, sync)
 }
@@ -181,6 +197,7 @@ func deleteCoreVolume(d *schema.ResourceData, m interface{}) error {
 type CoreVolumeResourceCrud struct {
 	BaseCrud
 	Client                 *oci_core.BlockstorageClient
+	Clients                *OracleClients
 	Res                    *oci_core.Volume
 	DisableNotFoundRetries bool
 }
@@ -313,6 +330,11 @@ func (s *CoreVolumeResourceCrud) Create() error {
 	}
 
 	s.Res = &response.Volume
+
+	// The volume exists at this point, failing to read the key version only means the key is assigned again on the next apply
+	if err := setVolumeKmsKeyVersionId(s.D, s.Clients); err != nil {
+		log.Printf("[WARN] Unable to read the current version of the KMS key of volume %s: %v", *s.Res.Id, err)
+	}
 	return nil
 }
 
@@ -330,6 +352,16 @@ func (s *CoreVolumeResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Volume
+
+	// The key is read from its dedicated endpoint, so that a key updated or removed outside of Terraform is detected
+	if s.Res.LifecycleState == oci_core.VolumeLifecycleStateAvailable {
+		kmsKeyId, err := s.getKmsKeyId()
+		if err != nil {
+			return err
+		}
+		s.Res.KmsKeyId = kmsKeyId
+	}
+
 	return nil
 }
 
@@ -362,19 +394,8 @@ func (s *CoreVolumeResourceCrud) Update() error {
 		request.FreeformTags = objectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
-	if s.D.HasChange("kms_key_id") {
-		keyUpdateRequest := oci_core.UpdateVolumeKmsKeyRequest{}
-
-		volumeId := s.D.Id()
-		keyUpdateRequest.VolumeId = &volumeId
-
-		tmp := s.D.Get("kms_key_id").(string)
-		keyUpdateRequest.KmsKeyId = &tmp
-
-		keyUpdateRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
-
-		_, err := s.Client.UpdateVolumeKmsKey(context.Background(), keyUpdateRequest)
-		if err != nil {
+	if s.D.HasChange("kms_key_id") || s.D.HasChange("kms_key_version_id") {
+		if err := s.updateKmsKey(); err != nil {
 			return err
 		}
 	}
@@ -414,6 +435,51 @@ func (s *CoreVolumeResourceCrud) Delete() error {
 	return err
 }
 
+func (s *CoreVolumeResourceCrud) getKmsKeyId() (*string, error) {
+	request := oci_core.GetVolumeKmsKeyRequest{}
+
+	volumeId := s.D.Id()
+	request.VolumeId = &volumeId
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	response, err := s.Client.GetVolumeKmsKey(context.Background(), request)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.KmsKeyId, nil
+}
+
+// updateKmsKey assigns the key to the volume, which is done again when the key was rotated so that the volume uses the
+// new key version. Removing the key reverts the volume to Oracle-managed keys.
+func (s *CoreVolumeResourceCrud) updateKmsKey() error {
+	volumeId := s.D.Id()
+
+	kmsKeyId := s.D.Get("kms_key_id").(string)
+	if kmsKeyId == "" {
+		request := oci_core.DeleteVolumeKmsKeyRequest{}
+		request.VolumeId = &volumeId
+
+		request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+
+		_, err := s.Client.DeleteVolumeKmsKey(context.Background(), request)
+		return err
+	}
+
+	request := oci_core.UpdateVolumeKmsKeyRequest{}
+	request.VolumeId = &volumeId
+	request.KmsKeyId = &kmsKeyId
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	if _, err := s.Client.UpdateVolumeKmsKey(context.Background(), request); err != nil {
+		return err
+	}
+
+	return setVolumeKmsKeyVersionId(s.D, s.Clients)
+}
+
 func (s *CoreVolumeResourceCrud) SetData() error {
 	if s.Res.AvailabilityDomain != nil {
 		s.D.Set("availability_domain", *s.Res.AvailabilityDomain)
@@ -439,6 +505,8 @@ func (s *CoreVolumeResourceCrud) SetData() error {
 
 	if s.Res.KmsKeyId != nil {
 		s.D.Set("kms_key_id", *s.Res.KmsKeyId)
+	} else {
+		s.D.Set("kms_key_id", "")
 	}
 
 	if s.Res.SizeInGBs != nil {
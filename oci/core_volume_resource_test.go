@@ -0,0 +1,181 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/terraform-providers/terraform-provider-oci/httpreplay"
+)
+
+var (
+	volumeKmsKeyRepresentation = map[string]interface{}{
+		"availability_domain":         Representation{repType: Required, create: `${data.oci_identity_availability_domains.test_availability_domains.availability_domains.0.name}`},
+		"compartment_id":              Representation{repType: Required, create: `${var.compartment_id}`},
+		"kms_key_id":                  Representation{repType: Required, create: `${lookup(data.oci_kms_keys.test_keys_dependency.keys[0], "id")}`},
+		"kms_key_management_endpoint": Representation{repType: Required, create: `${data.oci_kms_vault.test_vault.management_endpoint}`},
+	}
+
+	VolumeKmsKeyResourceDependencies = AvailabilityDomainConfig + KeyResourceDependencyConfig
+)
+
+func TestCoreVolumeResource_kmsKey(t *testing.T) {
+	httpreplay.SetScenario("TestCoreVolumeResource_kmsKey")
+	defer httpreplay.SaveScenario()
+
+	provider := testAccProvider
+	config := testProviderConfig()
+
+	compartmentId := getEnvSettingWithBlankDefault("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	resourceName := "oci_core_volume.test_volume"
+
+	var resId, resId2, keyVersionId string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Providers: map[string]terraform.ResourceProvider{
+			"oci": provider,
+		},
+		CheckDestroy: testAccCheckCoreVolumeDestroy,
+		Steps: []resource.TestStep{
+			// verify create with a customer-managed key
+			{
+				Config: config + compartmentIdVariableStr + VolumeKmsKeyResourceDependencies +
+					generateResourceFromRepresentationMap("oci_core_volume", "test_volume", Required, Create, volumeKmsKeyRepresentation),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "kms_key_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "kms_key_version_id"),
+
+					func(s *terraform.State) (err error) {
+						resId, err = fromInstanceState(s, resourceName, "id")
+						if err != nil {
+							return err
+						}
+						keyVersionId, err = fromInstanceState(s, resourceName, "kms_key_version_id")
+						return err
+					},
+				),
+			},
+			// rotate the key, the volume still has the previous key version, which the plan after the apply reports
+			{
+				Config: config + compartmentIdVariableStr + VolumeKmsKeyResourceDependencies +
+					generateResourceFromRepresentationMap("oci_kms_key_version", "test_key_version", Required, Create, keyVersionRepresentation) +
+					generateResourceFromRepresentationMap("oci_core_volume", "test_volume", Required, Create, volumeKmsKeyRepresentation),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					func(s *terraform.State) (err error) {
+						currentKeyVersionId, err := fromInstanceState(s, resourceName, "kms_key_version_id")
+						if err == nil && currentKeyVersionId != keyVersionId {
+							return fmt.Errorf("expected the volume to keep key version %s until the next apply, got %s", keyVersionId, currentKeyVersionId)
+						}
+						return err
+					},
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			// verify the key is assigned again after it was rotated
+			{
+				Config: config + compartmentIdVariableStr + VolumeKmsKeyResourceDependencies +
+					generateResourceFromRepresentationMap("oci_kms_key_version", "test_key_version", Required, Create, keyVersionRepresentation) +
+					generateResourceFromRepresentationMap("oci_core_volume", "test_volume", Required, Create, volumeKmsKeyRepresentation),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "kms_key_id"),
+					TestCheckResourceAttributesEqual(resourceName, "kms_key_version_id", "oci_kms_key_version.test_key_version", "key_version_id"),
+
+					func(s *terraform.State) (err error) {
+						resId2, err = fromInstanceState(s, resourceName, "id")
+						if resId != resId2 {
+							return fmt.Errorf("resource recreated when it was supposed to be updated")
+						}
+						return err
+					},
+				),
+			},
+			// verify unsetting the key keeps it
+			{
+				Config: config + compartmentIdVariableStr + VolumeKmsKeyResourceDependencies +
+					generateResourceFromRepresentationMap("oci_core_volume", "test_volume", Required, Create,
+						representationCopyWithRemovedProperties(volumeKmsKeyRepresentation, []string{"kms_key_id", "kms_key_management_endpoint"})),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "kms_key_id"),
+				),
+			},
+			// verify removing the key reverts the volume to Oracle-managed keys
+			{
+				Config: config + compartmentIdVariableStr + VolumeKmsKeyResourceDependencies +
+					generateResourceFromRepresentationMap("oci_core_volume", "test_volume", Required, Create,
+						representationCopyWithNewProperties(representationCopyWithRemovedProperties(volumeKmsKeyRepresentation, []string{"kms_key_id", "kms_key_management_endpoint"}), map[string]interface{}{
+							"remove_kms_key": Representation{repType: Required, create: `true`},
+						})),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "kms_key_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "kms_key_version_id", ""),
+
+					func(s *terraform.State) (err error) {
+						resId2, err = fromInstanceState(s, resourceName, "id")
+						if resId != resId2 {
+							return fmt.Errorf("resource recreated when it was supposed to be updated")
+						}
+						return err
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestUnitCoreVolumeResource_kmsKeyDiff(t *testing.T) {
+	volumeConfig := func(c map[string]interface{}) *terraform.ResourceConfig {
+		c["availability_domain"] = "ad-1"
+		c["compartment_id"] = "ocid1.compartment.oc1..aaaa"
+		raw, err := config.NewRawConfig(c)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return terraform.NewResourceConfig(raw)
+	}
+	existing := &terraform.InstanceState{ID: "ocid1.volume.oc1..aaaa", Attributes: map[string]string{
+		"availability_domain": "ad-1",
+		"compartment_id":      "ocid1.compartment.oc1..aaaa",
+		"kms_key_id":          "ocid1.key.oc1..aaaa",
+		"kms_key_version_id":  "ocid1.keyversion.oc1..aaaa",
+		"size_in_mbs":         "51200",
+		"source_details.#":    "0",
+		"volume_backup_id":    "",
+	}}
+	volume := CoreVolumeResource()
+
+	// A key that is not in the config, e.g. the key of the source, is kept
+	diff, err := volume.Diff(existing, volumeConfig(map[string]interface{}{}), nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if diff != nil && (diff.Attributes["kms_key_id"] != nil || diff.Attributes["kms_key_version_id"] != nil) {
+		t.Errorf("Expected no change to the key when it is not set, got %v", diff.Attributes)
+	}
+
+	diff, err = volume.Diff(existing, volumeConfig(map[string]interface{}{"remove_kms_key": true}), nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if attr := diff.Attributes["kms_key_id"]; attr == nil || attr.New != "" {
+		t.Errorf("Expected the key to be removed, got %v", attr)
+	}
+	if attr := diff.Attributes["kms_key_version_id"]; attr == nil || attr.New != "" {
+		t.Errorf("Expected the key version to be removed, got %v", attr)
+	}
+
+	diff, err = volume.Diff(existing, volumeConfig(map[string]interface{}{"kms_key_id": "ocid1.key.oc1..bbbb"}), nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if attr := diff.Attributes["kms_key_version_id"]; attr == nil || !attr.NewComputed {
+		t.Errorf("Expected the key version of a new key to be computed, got %v", attr)
+	}
+}
@@ -20,7 +20,6 @@ import (
 	oci_load_balancer "github.com/oracle/oci-go-sdk/loadbalancer"
 
 	"github.com/terraform-providers/terraform-provider-oci/httpreplay"
-	"github.com/terraform-providers/terraform-provider-oci/metrics"
 )
 
 var (
@@ -295,7 +294,6 @@ func CreateDBSystemResource(d *schema.ResourceData, sync ResourceCreator) error
 }
 
 func CreateResource(d *schema.ResourceData, sync ResourceCreator) error {
-	start := time.Now()
 	if synchronizedResource, ok := sync.(SynchronizedResource); ok {
 		if mutex := synchronizedResource.GetMutex(); mutex != nil {
 			mutex.Lock()
@@ -304,9 +302,6 @@ func CreateResource(d *schema.ResourceData, sync ResourceCreator) error {
 	}
 
 	if e := sync.Create(); e != nil {
-		if metrics.ShouldWriteMetrics() {
-			metrics.SaveResourceDurationMetric(getResourceName(sync), "Create", FAILED, elaspedInMillisecond(start))
-		}
 		return e
 	}
 
@@ -321,10 +316,6 @@ func CreateResource(d *schema.ResourceData, sync ResourceCreator) error {
 				sync.VoidState()
 			}
 
-			if metrics.ShouldWriteMetrics() {
-				metrics.SaveResourceDurationMetric(getResourceName(sync), "Create", FAILED, elaspedInMillisecond(start))
-			}
-
 			//We need to SetData() here because if there is an error or timeout in the wait for state after the Create() was successful we want to store the resource in the statefile to avoid dangling resources
 			if setDataErr := sync.SetData(); setDataErr != nil {
 				log.Printf("[ERROR] error setting data after waitForStateRefresh() error: %v", setDataErr)
@@ -336,9 +327,6 @@ func CreateResource(d *schema.ResourceData, sync ResourceCreator) error {
 
 	d.SetId(sync.ID())
 	if e := sync.SetData(); e != nil {
-		if metrics.ShouldWriteMetrics() {
-			metrics.SaveResourceDurationMetric(getResourceName(sync), "Create", FAILED, elaspedInMillisecond(start))
-		}
 		return e
 	}
 
@@ -346,9 +334,6 @@ func CreateResource(d *schema.ResourceData, sync ResourceCreator) error {
 		time.Sleep(ew.ExtraWaitPostCreateDelete())
 	}
 
-	if metrics.ShouldWriteMetrics() {
-		metrics.SaveResourceDurationMetric(getResourceName(sync), "Create", SUCCEEDED, elaspedInMillisecond(start))
-	}
 	return nil
 }
 
@@ -377,7 +362,6 @@ func ReadResource(sync ResourceReader) error {
 }
 
 func UpdateResource(d *schema.ResourceData, sync ResourceUpdater) error {
-	start := time.Now()
 	if synchronizedResource, ok := sync.(SynchronizedResource); ok {
 		if mutex := synchronizedResource.GetMutex(); mutex != nil {
 			mutex.Lock()
@@ -387,34 +371,20 @@ func UpdateResource(d *schema.ResourceData, sync ResourceUpdater) error {
 
 	d.Partial(true)
 	if e := sync.Update(); e != nil {
-		if metrics.ShouldWriteMetrics() {
-			metrics.SaveResourceDurationMetric(getResourceName(sync), "Update", FAILED, elaspedInMillisecond(start))
-		}
-
 		return e
 	}
 	d.Partial(false)
 
 	if stateful, ok := sync.(StatefullyUpdatedResource); ok {
 		if e := waitForStateRefresh(stateful, d.Timeout(schema.TimeoutUpdate), "update", stateful.UpdatedPending(), stateful.UpdatedTarget()); e != nil {
-			if metrics.ShouldWriteMetrics() {
-				metrics.SaveResourceDurationMetric(getResourceName(sync), "Update", FAILED, elaspedInMillisecond(start))
-			}
-
 			return e
 		}
 	}
 
 	if e := sync.SetData(); e != nil {
-		if metrics.ShouldWriteMetrics() {
-			metrics.SaveResourceDurationMetric(getResourceName(sync), "Update", FAILED, elaspedInMillisecond(start))
-		}
 		return e
 	}
 
-	if metrics.ShouldWriteMetrics() {
-		metrics.SaveResourceDurationMetric(getResourceName(sync), "Update", SUCCEEDED, elaspedInMillisecond(start))
-	}
 	return nil
 }
 
@@ -423,7 +393,6 @@ func UpdateResource(d *schema.ResourceData, sync ResourceUpdater) error {
 // () -> Pending -> Deleted.
 // Finally, sets the ResourceData state to empty.
 func DeleteResource(d *schema.ResourceData, sync ResourceDeleter) error {
-	start := time.Now()
 	if synchronizedResource, ok := sync.(SynchronizedResource); ok {
 		if mutex := synchronizedResource.GetMutex(); mutex != nil {
 			mutex.Lock()
@@ -431,29 +400,14 @@ func DeleteResource(d *schema.ResourceData, sync ResourceDeleter) error {
 		}
 	}
 
-	result := SUCCEEDED
 	if e := sync.Delete(); e != nil {
 		handleMissingResourceError(sync, &e)
-		if e != nil {
-			result = FAILED
-		}
-
-		if metrics.ShouldWriteMetrics() {
-			metrics.SaveResourceDurationMetric(getResourceName(sync), "Delete", result, elaspedInMillisecond(start))
-		}
 		return e
 	}
 
 	if stateful, ok := sync.(StatefullyDeletedResource); ok {
 		if e := waitForStateRefresh(stateful, d.Timeout(schema.TimeoutDelete), "deletion", stateful.DeletedPending(), stateful.DeletedTarget()); e != nil {
 			handleMissingResourceError(sync, &e)
-			if e != nil {
-				result = FAILED
-			}
-
-			if metrics.ShouldWriteMetrics() {
-				metrics.SaveResourceDurationMetric(getResourceName(sync), "Delete", result, elaspedInMillisecond(start))
-			}
 			return e
 		}
 	}
@@ -468,16 +422,59 @@ func DeleteResource(d *schema.ResourceData, sync ResourceDeleter) error {
 
 	sync.VoidState()
 
-	if metrics.ShouldWriteMetrics() {
-		metrics.SaveResourceDurationMetric(getResourceName(sync), "Delete", SUCCEEDED, elaspedInMillisecond(start))
+	return nil
+}
+
+// addResourcesDurationMetrics records the duration of the creates, updates and deletes of the resources, including the
+// wait for their state, with the metrics recorder of the provider that manages them
+func addResourcesDurationMetrics(resources map[string]*schema.Resource) map[string]*schema.Resource {
+	for name, resource := range resources {
+		addResourceDurationMetrics(name, resource)
 	}
 
-	return nil
+	return resources
+}
+
+func addResourceDurationMetrics(name string, resource *schema.Resource) {
+	if createFn := resource.Create; createFn != nil {
+		resource.Create = func(d *schema.ResourceData, m interface{}) error {
+			start := time.Now()
+			err := createFn(d, m)
+			saveResourceDurationMetric(m, name, "Create", start, err)
+			return err
+		}
+	}
+
+	if updateFn := resource.Update; updateFn != nil {
+		resource.Update = func(d *schema.ResourceData, m interface{}) error {
+			start := time.Now()
+			err := updateFn(d, m)
+			saveResourceDurationMetric(m, name, "Update", start, err)
+			return err
+		}
+	}
+
+	if deleteFn := resource.Delete; deleteFn != nil {
+		resource.Delete = func(d *schema.ResourceData, m interface{}) error {
+			start := time.Now()
+			err := deleteFn(d, m)
+			saveResourceDurationMetric(m, name, "Delete", start, err)
+			return err
+		}
+	}
 }
 
-func getResourceName(sync interface{}) string {
-	syncTypeName := reflect.TypeOf(sync).String()
-	return syncTypeName[strings.Index(syncTypeName, ".")+1 : strings.Index(syncTypeName, "ResourceCrud")]
+func saveResourceDurationMetric(m interface{}, resourceName string, operation string, start time.Time, err error) {
+	clients, ok := m.(*OracleClients)
+	if !ok || !clients.metricsRecorder.ShouldWriteMetrics() {
+		return
+	}
+
+	result := SUCCEEDED
+	if err != nil {
+		result = FAILED
+	}
+	clients.metricsRecorder.SaveResourceDurationMetric(resourceName, operation, result, elaspedInMillisecond(start))
 }
 
 func stateRefreshFunc(sync StatefulResource) resource.StateRefreshFunc {
@@ -4,9 +4,14 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 
+	oci_common "github.com/oracle/oci-go-sdk/common"
 	oci_database "github.com/oracle/oci-go-sdk/database"
 )
 
@@ -72,6 +77,11 @@ func DatabaseAutonomousDataWarehouseResource() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
+			"restore_timestamp": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRFC3339TimeString,
+			},
 
 			// Computed
 			"connection_strings": {
@@ -119,14 +129,21 @@ func DatabaseAutonomousDataWarehouseResource() *schema.Resource {
 				Computed: true,
 			},
 			"state": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: EqualIgnoreCaseSuppressDiff,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_database.AutonomousDataWarehouseLifecycleStateAvailable),
+					string(oci_database.AutonomousDataWarehouseLifecycleStateStopped),
+				}, true),
 			},
 			"time_created": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
 		},
+		CustomizeDiff: validateRestoreTimestampOnCreate,
 	}
 }
 
@@ -135,7 +152,25 @@ func createDatabaseAutonomousDataWarehouse(d *schema.ResourceData, m interface{}
 	sync.D = d
 	sync.Client = m.(*OracleClients).databaseClient
 
-	return CreateResource(d, sync)
+	var stop = false
+	if state, ok := sync.D.GetOkExists("state"); ok {
+		wantedState := oci_database.AutonomousDataWarehouseLifecycleStateEnum(strings.ToUpper(state.(string)))
+		if wantedState == oci_database.AutonomousDataWarehouseLifecycleStateStopped {
+			stop = true
+		}
+	}
+
+	if e := CreateResource(d, sync); e != nil {
+		return e
+	}
+
+	if stop {
+		if err := sync.StopAutonomousDataWarehouse(); err != nil {
+			return err
+		}
+		return ReadResource(sync)
+	}
+	return nil
 }
 
 func readDatabaseAutonomousDataWarehouse(d *schema.ResourceData, m interface{}) error {
@@ -151,7 +186,44 @@ func updateDatabaseAutonomousDataWarehouse(d *schema.ResourceData, m interface{}
 	sync.D = d
 	sync.Client = m.(*OracleClients).databaseClient
 
-	return UpdateResource(d, sync)
+	start, stop := false, false
+
+	if sync.D.HasChange("state") {
+		wantedState := strings.ToUpper(sync.D.Get("state").(string))
+		if oci_database.AutonomousDataWarehouseLifecycleStateAvailable == oci_database.AutonomousDataWarehouseLifecycleStateEnum(wantedState) {
+			start = true
+		} else if oci_database.AutonomousDataWarehouseLifecycleStateStopped == oci_database.AutonomousDataWarehouseLifecycleStateEnum(wantedState) {
+			stop = true
+		}
+	}
+
+	if start {
+		if err := sync.StartAutonomousDataWarehouse(); err != nil {
+			return err
+		}
+		sync.D.Set("state", oci_database.AutonomousDataWarehouseLifecycleStateAvailable)
+	}
+
+	if err := UpdateResource(d, sync); err != nil {
+		return err
+	}
+
+	if restoreTimestamp, ok := sync.D.GetOkExists("restore_timestamp"); ok && sync.D.HasChange("restore_timestamp") {
+		if err := sync.RestoreAutonomousDataWarehouse(restoreTimestamp.(string)); err != nil {
+			// Keep the previous timestamp in the state, so that the restore is attempted again by the next apply
+			oldRestoreTimestamp, _ := sync.D.GetChange("restore_timestamp")
+			sync.D.Set("restore_timestamp", oldRestoreTimestamp)
+			return err
+		}
+	}
+
+	if stop {
+		if err := sync.StopAutonomousDataWarehouse(); err != nil {
+			return err
+		}
+		sync.D.Set("state", oci_database.AutonomousDataWarehouseLifecycleStateStopped)
+	}
+	return nil
 }
 
 func deleteDatabaseAutonomousDataWarehouse(d *schema.ResourceData, m interface{}) error {
@@ -352,6 +424,79 @@ func (s *DatabaseAutonomousDataWarehouseResourceCrud) Delete() error {
 	return err
 }
 
+func (s *DatabaseAutonomousDataWarehouseResourceCrud) StartAutonomousDataWarehouse() error {
+	request := oci_database.StartAutonomousDataWarehouseRequest{}
+
+	tmp := s.D.Id()
+	request.AutonomousDataWarehouseId = &tmp
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
+
+	_, err := s.Client.StartAutonomousDataWarehouse(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	retentionPolicyFunc := func() bool {
+		return s.Res.LifecycleState == oci_database.AutonomousDataWarehouseLifecycleStateAvailable
+	}
+	return WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
+}
+
+func (s *DatabaseAutonomousDataWarehouseResourceCrud) StopAutonomousDataWarehouse() error {
+	request := oci_database.StopAutonomousDataWarehouseRequest{}
+
+	tmp := s.D.Id()
+	request.AutonomousDataWarehouseId = &tmp
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
+
+	_, err := s.Client.StopAutonomousDataWarehouse(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	retentionPolicyFunc := func() bool { return s.Res.LifecycleState == oci_database.AutonomousDataWarehouseLifecycleStateStopped }
+	return WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
+}
+
+// RestoreAutonomousDataWarehouse restores the Autonomous Data Warehouse to a point in time and waits for the restore to finish
+func (s *DatabaseAutonomousDataWarehouseResourceCrud) RestoreAutonomousDataWarehouse(restoreTimestamp string) error {
+	request := oci_database.RestoreAutonomousDataWarehouseRequest{}
+
+	tmp := s.D.Id()
+	request.AutonomousDataWarehouseId = &tmp
+
+	timestamp, err := time.Parse(time.RFC3339, restoreTimestamp)
+	if err != nil {
+		return err
+	}
+	request.Timestamp = &oci_common.SDKTime{Time: timestamp}
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
+
+	response, err := s.Client.RestoreAutonomousDataWarehouse(context.Background(), request)
+	if err != nil {
+		return err
+	}
+	s.Res = &response.AutonomousDataWarehouse
+
+	// The database is returned in the RESTORE_IN_PROGRESS state once the restore is accepted, it is finished as soon as it leaves
+	// that state, e.g. when the restore finished before the first poll or failed with RESTORE_FAILED
+	restoreFinishedFunc := func() bool {
+		return s.Res.LifecycleState != oci_database.AutonomousDataWarehouseLifecycleStateRestoreInProgress
+	}
+	if err := WaitForResourceCondition(s, restoreFinishedFunc, s.D.Timeout(schema.TimeoutUpdate)); err != nil {
+		return err
+	}
+
+	if s.Res.LifecycleState != oci_database.AutonomousDataWarehouseLifecycleStateAvailable && s.Res.LifecycleState != oci_database.AutonomousDataWarehouseLifecycleStateStopped {
+		return fmt.Errorf("restore of Autonomous Data Warehouse %s to %s finished in state %s", s.D.Id(), restoreTimestamp, s.Res.LifecycleState)
+	}
+
+	return s.SetData()
+}
+
 func (s *DatabaseAutonomousDataWarehouseResourceCrud) SetData() error {
 	if s.Res.CompartmentId != nil {
 		s.D.Set("compartment_id", *s.Res.CompartmentId)
@@ -150,6 +150,40 @@ func TestDatabaseAutonomousDataWarehouseResource_basic(t *testing.T) {
 					},
 				),
 			},
+			// verify stop
+			{
+				Config: config + compartmentIdVariableStr + AutonomousDataWarehouseResourceDependencies +
+					generateResourceFromRepresentationMap("oci_database_autonomous_data_warehouse", "test_autonomous_data_warehouse", Optional, Update, representationCopyWithNewProperties(autonomousDataWarehouseRepresentation, map[string]interface{}{"state": Representation{repType: Optional, create: `STOPPED`}})),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "display_name", "displayName2"),
+					resource.TestCheckResourceAttr(resourceName, "state", "STOPPED"),
+
+					func(s *terraform.State) (err error) {
+						resId2, err = fromInstanceState(s, resourceName, "id")
+						if resId != resId2 {
+							return fmt.Errorf("Resource recreated when it was supposed to be updated.")
+						}
+						return err
+					},
+				),
+			},
+			// verify start
+			{
+				Config: config + compartmentIdVariableStr + AutonomousDataWarehouseResourceDependencies +
+					generateResourceFromRepresentationMap("oci_database_autonomous_data_warehouse", "test_autonomous_data_warehouse", Optional, Update, representationCopyWithNewProperties(autonomousDataWarehouseRepresentation, map[string]interface{}{"state": Representation{repType: Optional, create: `AVAILABLE`}})),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "display_name", "displayName2"),
+					resource.TestCheckResourceAttr(resourceName, "state", "AVAILABLE"),
+
+					func(s *terraform.State) (err error) {
+						resId2, err = fromInstanceState(s, resourceName, "id")
+						if resId != resId2 {
+							return fmt.Errorf("Resource recreated when it was supposed to be updated.")
+						}
+						return err
+					},
+				),
+			},
 			// verify datasource
 			{
 				Config: config +
@@ -213,6 +247,7 @@ func TestDatabaseAutonomousDataWarehouseResource_basic(t *testing.T) {
 				ImportStateVerifyIgnore: []string{
 					"admin_password",
 					"lifecycle_details",
+					"restore_timestamp",
 				},
 				ResourceName: resourceName,
 			},
@@ -6,10 +6,12 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 
+	oci_common "github.com/oracle/oci-go-sdk/common"
 	oci_database "github.com/oracle/oci-go-sdk/database"
 )
 
@@ -107,6 +109,11 @@ func DatabaseAutonomousDatabaseResource() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"restore_timestamp": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRFC3339TimeString,
+			},
 			"source": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -210,8 +217,14 @@ func DatabaseAutonomousDatabaseResource() *schema.Resource {
 				Computed: true,
 			},
 			"state": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: EqualIgnoreCaseSuppressDiff,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_database.AutonomousDatabaseLifecycleStateAvailable),
+					string(oci_database.AutonomousDatabaseLifecycleStateStopped),
+				}, true),
 			},
 			"time_created": {
 				Type:     schema.TypeString,
@@ -222,6 +235,7 @@ func DatabaseAutonomousDatabaseResource() *schema.Resource {
 				Computed: true,
 			},
 		},
+		CustomizeDiff: validateRestoreTimestampOnCreate,
 	}
 }
 
@@ -230,7 +244,25 @@ func createDatabaseAutonomousDatabase(d *schema.ResourceData, m interface{}) err
 	sync.D = d
 	sync.Client = m.(*OracleClients).databaseClient
 
-	return CreateResource(d, sync)
+	var stop = false
+	if state, ok := sync.D.GetOkExists("state"); ok {
+		wantedState := oci_database.AutonomousDatabaseLifecycleStateEnum(strings.ToUpper(state.(string)))
+		if wantedState == oci_database.AutonomousDatabaseLifecycleStateStopped {
+			stop = true
+		}
+	}
+
+	if e := CreateResource(d, sync); e != nil {
+		return e
+	}
+
+	if stop {
+		if err := sync.StopAutonomousDatabase(); err != nil {
+			return err
+		}
+		return ReadResource(sync)
+	}
+	return nil
 }
 
 func readDatabaseAutonomousDatabase(d *schema.ResourceData, m interface{}) error {
@@ -246,7 +278,44 @@ func updateDatabaseAutonomousDatabase(d *schema.ResourceData, m interface{}) err
 	sync.D = d
 	sync.Client = m.(*OracleClients).databaseClient
 
-	return UpdateResource(d, sync)
+	start, stop := false, false
+
+	if sync.D.HasChange("state") {
+		wantedState := strings.ToUpper(sync.D.Get("state").(string))
+		if oci_database.AutonomousDatabaseLifecycleStateAvailable == oci_database.AutonomousDatabaseLifecycleStateEnum(wantedState) {
+			start = true
+		} else if oci_database.AutonomousDatabaseLifecycleStateStopped == oci_database.AutonomousDatabaseLifecycleStateEnum(wantedState) {
+			stop = true
+		}
+	}
+
+	if start {
+		if err := sync.StartAutonomousDatabase(); err != nil {
+			return err
+		}
+		sync.D.Set("state", oci_database.AutonomousDatabaseLifecycleStateAvailable)
+	}
+
+	if err := UpdateResource(d, sync); err != nil {
+		return err
+	}
+
+	if restoreTimestamp, ok := sync.D.GetOkExists("restore_timestamp"); ok && sync.D.HasChange("restore_timestamp") {
+		if err := sync.RestoreAutonomousDatabase(restoreTimestamp.(string)); err != nil {
+			// Keep the previous timestamp in the state, so that the restore is attempted again by the next apply
+			oldRestoreTimestamp, _ := sync.D.GetChange("restore_timestamp")
+			sync.D.Set("restore_timestamp", oldRestoreTimestamp)
+			return err
+		}
+	}
+
+	if stop {
+		if err := sync.StopAutonomousDatabase(); err != nil {
+			return err
+		}
+		sync.D.Set("state", oci_database.AutonomousDatabaseLifecycleStateStopped)
+	}
+	return nil
 }
 
 func deleteDatabaseAutonomousDatabase(d *schema.ResourceData, m interface{}) error {
@@ -438,6 +507,77 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) Delete() error {
 	return err
 }
 
+func (s *DatabaseAutonomousDatabaseResourceCrud) StartAutonomousDatabase() error {
+	request := oci_database.StartAutonomousDatabaseRequest{}
+
+	tmp := s.D.Id()
+	request.AutonomousDatabaseId = &tmp
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
+
+	_, err := s.Client.StartAutonomousDatabase(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	retentionPolicyFunc := func() bool { return s.Res.LifecycleState == oci_database.AutonomousDatabaseLifecycleStateAvailable }
+	return WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
+}
+
+func (s *DatabaseAutonomousDatabaseResourceCrud) StopAutonomousDatabase() error {
+	request := oci_database.StopAutonomousDatabaseRequest{}
+
+	tmp := s.D.Id()
+	request.AutonomousDatabaseId = &tmp
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
+
+	_, err := s.Client.StopAutonomousDatabase(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	retentionPolicyFunc := func() bool { return s.Res.LifecycleState == oci_database.AutonomousDatabaseLifecycleStateStopped }
+	return WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
+}
+
+// RestoreAutonomousDatabase restores the Autonomous Database to a point in time and waits for the restore to finish
+func (s *DatabaseAutonomousDatabaseResourceCrud) RestoreAutonomousDatabase(restoreTimestamp string) error {
+	request := oci_database.RestoreAutonomousDatabaseRequest{}
+
+	tmp := s.D.Id()
+	request.AutonomousDatabaseId = &tmp
+
+	timestamp, err := time.Parse(time.RFC3339, restoreTimestamp)
+	if err != nil {
+		return err
+	}
+	request.Timestamp = &oci_common.SDKTime{Time: timestamp}
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
+
+	response, err := s.Client.RestoreAutonomousDatabase(context.Background(), request)
+	if err != nil {
+		return err
+	}
+	s.Res = &response.AutonomousDatabase
+
+	// The database is returned in the RESTORE_IN_PROGRESS state once the restore is accepted, it is finished as soon as it leaves
+	// that state, e.g. when the restore finished before the first poll or failed with RESTORE_FAILED
+	restoreFinishedFunc := func() bool {
+		return s.Res.LifecycleState != oci_database.AutonomousDatabaseLifecycleStateRestoreInProgress
+	}
+	if err := WaitForResourceCondition(s, restoreFinishedFunc, s.D.Timeout(schema.TimeoutUpdate)); err != nil {
+		return err
+	}
+
+	if s.Res.LifecycleState != oci_database.AutonomousDatabaseLifecycleStateAvailable && s.Res.LifecycleState != oci_database.AutonomousDatabaseLifecycleStateStopped {
+		return fmt.Errorf("restore of Autonomous Database %s to %s finished in state %s", s.D.Id(), restoreTimestamp, s.Res.LifecycleState)
+	}
+
+	return s.SetData()
+}
+
 func (s *DatabaseAutonomousDatabaseResourceCrud) SetData() error {
 	if s.Res.AutonomousContainerDatabaseId != nil {
 		s.D.Set("autonomous_container_database_id", *s.Res.AutonomousContainerDatabaseId)
@@ -720,3 +860,14 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) updateCompartment(compartment i
 	}
 	return nil
 }
+
+// A point in time restore needs an existing database, restore_timestamp can only be set once the database is created
+func validateRestoreTimestampOnCreate(d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() != "" {
+		return nil
+	}
+	if restoreTimestamp, ok := d.GetOk("restore_timestamp"); ok {
+		return fmt.Errorf("restore_timestamp %s can't be set when the database is created, set it once the database exists to restore it to that point in time", restoreTimestamp)
+	}
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
@@ -273,6 +274,40 @@ func TestDatabaseAutonomousDatabaseResource_basic(t *testing.T) {
 					},
 				),
 			},
+			// verify stop
+			{
+				Config: config + compartmentIdVariableStr + AutonomousDatabaseResourceDependencies +
+					generateResourceFromRepresentationMap("oci_database_autonomous_database", "test_autonomous_database", Optional, Update, representationCopyWithNewProperties(autonomousDatabaseRepresentation, map[string]interface{}{"state": Representation{repType: Optional, create: `STOPPED`}})),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "display_name", "displayName2"),
+					resource.TestCheckResourceAttr(resourceName, "state", "STOPPED"),
+
+					func(s *terraform.State) (err error) {
+						resId2, err = fromInstanceState(s, resourceName, "id")
+						if resId != resId2 {
+							return fmt.Errorf("Resource recreated when it was supposed to be updated.")
+						}
+						return err
+					},
+				),
+			},
+			// verify start
+			{
+				Config: config + compartmentIdVariableStr + AutonomousDatabaseResourceDependencies +
+					generateResourceFromRepresentationMap("oci_database_autonomous_database", "test_autonomous_database", Optional, Update, representationCopyWithNewProperties(autonomousDatabaseRepresentation, map[string]interface{}{"state": Representation{repType: Optional, create: `AVAILABLE`}})),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "display_name", "displayName2"),
+					resource.TestCheckResourceAttr(resourceName, "state", "AVAILABLE"),
+
+					func(s *terraform.State) (err error) {
+						resId2, err = fromInstanceState(s, resourceName, "id")
+						if resId != resId2 {
+							return fmt.Errorf("Resource recreated when it was supposed to be updated.")
+						}
+						return err
+					},
+				),
+			},
 			// verify datasource
 			{
 				Config: config +
@@ -347,6 +382,7 @@ func TestDatabaseAutonomousDatabaseResource_basic(t *testing.T) {
 					"admin_password",
 					"clone_type",
 					"is_preview_version_with_service_terms_accepted",
+					"restore_timestamp",
 					"source",
 					"source_id",
 					"lifecycle_details",
@@ -610,3 +646,42 @@ func autonomousDatabaseSweepResponseFetchOperation(client *OracleClients, resour
 	})
 	return err
 }
+
+func TestUnitDatabaseAutonomousDatabaseResource_restoreTimestampOnCreate(t *testing.T) {
+	resourceConfig := func(values map[string]interface{}) *terraform.ResourceConfig {
+		raw, err := config.NewRawConfig(values)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return terraform.NewResourceConfig(raw)
+	}
+	createValues := map[string]interface{}{
+		"admin_password":           "BEstrO0ng_#11",
+		"compartment_id":           "ocid1.compartment.oc1..aaaa",
+		"cpu_core_count":           1,
+		"data_storage_size_in_tbs": 1,
+		"db_name":                  "adatabasedb1",
+	}
+
+	for _, resource := range []*schema.Resource{DatabaseAutonomousDatabaseResource(), DatabaseAutonomousDataWarehouseResource()} {
+		if _, err := resource.Diff(nil, resourceConfig(createValues), nil); err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+
+		createValues["restore_timestamp"] = "2019-08-01T10:00:00Z"
+		if _, err := resource.Diff(nil, resourceConfig(createValues), nil); err == nil {
+			t.Errorf("Expected an error when restore_timestamp is set on create")
+		}
+
+		existing := &terraform.InstanceState{ID: "ocid1.autonomousdatabase.oc1..aaaa", Attributes: map[string]string{}}
+		for key, value := range createValues {
+			if key != "restore_timestamp" {
+				existing.Attributes[key] = fmt.Sprintf("%v", value)
+			}
+		}
+		if _, err := resource.Diff(existing, resourceConfig(createValues), nil); err != nil {
+			t.Errorf("Unexpected error when restore_timestamp is set on an existing database: %v", err)
+		}
+		delete(createValues, "restore_timestamp")
+	}
+}
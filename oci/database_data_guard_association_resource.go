@@ -5,6 +5,8 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"regexp"
 	"strings"
 	"time"
 
@@ -19,6 +21,9 @@ import (
 
 func DatabaseDataGuardAssociationResource() *schema.Resource {
 	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: importDatabaseDataGuardAssociation,
+		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: &TwoHours,
 			Delete: &TwoHours,
@@ -110,6 +115,20 @@ func DatabaseDataGuardAssociationResource() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
+			"primary_database_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"role_transition": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: EqualIgnoreCaseSuppressDiff,
+				ValidateFunc: validation.StringInSlice([]string{
+					dataGuardRoleTransitionSwitchover,
+					dataGuardRoleTransitionFailover,
+				}, true),
+			},
 			"subnet_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -279,11 +298,106 @@ func (s *DatabaseDataGuardAssociationResourceCrud) Get() error {
 	return nil
 }
 
-//We need to have an empty delete because otherwise the added delete_standby_db_home_on_delete would have to be marked as ForceNew, which is undesireable. This way the update will pass the new value for this property from the config to the statefile.
+// Apart from role transitions we need to have an empty update because otherwise the added delete_standby_db_home_on_delete would have to be marked as ForceNew, which is undesireable. This way the update will pass the new value for this property from the config to the statefile.
 func (s *DatabaseDataGuardAssociationResourceCrud) Update() error {
+	if primaryDatabaseId, ok := s.D.GetOkExists("primary_database_id"); ok && s.D.HasChange("primary_database_id") {
+		if err := s.transitionRoles(primaryDatabaseId.(string)); err != nil {
+			return err
+		}
+	}
+
 	return s.Get()
 }
 
+// transitionRoles makes primaryDatabaseId the primary database of the association. A disabled standby is reinstated before it
+// takes over, and a failover is only performed when requested with role_transition.
+func (s *DatabaseDataGuardAssociationResourceCrud) transitionRoles(primaryDatabaseId string) error {
+	if err := s.Get(); err != nil {
+		return err
+	}
+
+	if s.Res.DatabaseId == nil || s.Res.PeerDatabaseId == nil || s.Res.PeerDataGuardAssociationId == nil {
+		return fmt.Errorf("the peer of data guard association %s is not known yet", s.D.Id())
+	}
+
+	// The roles as seen from the database that should become primary, and from the one that should become standby
+	waiter := &DatabaseDataGuardRoleTransitionCrud{Client: s.Client, DisableNotFoundRetries: s.DisableNotFoundRetries}
+	waiter.D = s.D
+	switch primaryDatabaseId {
+	case *s.Res.DatabaseId:
+		waiter.PrimaryDatabaseId, waiter.PrimaryDataGuardAssociationId = *s.Res.DatabaseId, s.D.Id()
+		waiter.StandbyDatabaseId, waiter.StandbyDataGuardAssociationId = *s.Res.PeerDatabaseId, *s.Res.PeerDataGuardAssociationId
+	case *s.Res.PeerDatabaseId:
+		waiter.PrimaryDatabaseId, waiter.PrimaryDataGuardAssociationId = *s.Res.PeerDatabaseId, *s.Res.PeerDataGuardAssociationId
+		waiter.StandbyDatabaseId, waiter.StandbyDataGuardAssociationId = *s.Res.DatabaseId, s.D.Id()
+	default:
+		return fmt.Errorf("primary_database_id %s is neither the database %s nor the peer database %s of the data guard association", primaryDatabaseId, *s.Res.DatabaseId, *s.Res.PeerDatabaseId)
+	}
+
+	if err := waiter.Get(); err != nil {
+		return err
+	}
+
+	if waiter.Res.Role == oci_database.DataGuardAssociationRolePrimary {
+		return nil
+	}
+
+	password := s.D.Get("database_admin_password").(string)
+
+	if waiter.Res.Role == oci_database.DataGuardAssociationRoleDisabledStandby {
+		request := oci_database.ReinstateDataGuardAssociationRequest{}
+		request.DatabaseId = &waiter.PrimaryDatabaseId
+		request.DataGuardAssociationId = &waiter.PrimaryDataGuardAssociationId
+		request.DatabaseAdminPassword = &password
+		request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
+
+		if _, err := s.Client.ReinstateDataGuardAssociation(context.Background(), request); err != nil {
+			return err
+		}
+
+		waiter.WantedRole, waiter.WantedPeerRoles = oci_database.DataGuardAssociationRoleStandby, []oci_database.DataGuardAssociationPeerRoleEnum{oci_database.DataGuardAssociationPeerRolePrimary}
+		if err := waitForStateRefresh(waiter, s.D.Timeout(schema.TimeoutUpdate), "reinstate", waiter.Pending(), waiter.Target()); err != nil {
+			return err
+		}
+	}
+
+	if strings.EqualFold(s.D.Get("role_transition").(string), dataGuardRoleTransitionFailover) {
+		request := oci_database.FailoverDataGuardAssociationRequest{}
+		request.DatabaseId = &waiter.PrimaryDatabaseId
+		request.DataGuardAssociationId = &waiter.PrimaryDataGuardAssociationId
+		request.DatabaseAdminPassword = &password
+		request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
+
+		if _, err := s.Client.FailoverDataGuardAssociation(context.Background(), request); err != nil {
+			return err
+		}
+
+		waiter.WantedRole, waiter.WantedPeerRoles = oci_database.DataGuardAssociationRolePrimary, []oci_database.DataGuardAssociationPeerRoleEnum{oci_database.DataGuardAssociationPeerRoleStandby, oci_database.DataGuardAssociationPeerRoleDisabledStandby}
+		if err := waitForStateRefresh(waiter, s.D.Timeout(schema.TimeoutUpdate), "failover", waiter.Pending(), waiter.Target()); err != nil {
+			return err
+		}
+
+		if waiter.Res.PeerRole == oci_database.DataGuardAssociationPeerRoleDisabledStandby {
+			return fmt.Errorf("failover to database %s succeeded, but the former primary database %s is now a disabled standby and must be reinstated. Set primary_database_id to %s to reinstate it and switch back, or reinstate it outside of terraform", waiter.PrimaryDatabaseId, waiter.StandbyDatabaseId, waiter.StandbyDatabaseId)
+		}
+		return nil
+	}
+
+	// A switchover is performed through the association of the current primary database
+	request := oci_database.SwitchoverDataGuardAssociationRequest{}
+	request.DatabaseId = &waiter.StandbyDatabaseId
+	request.DataGuardAssociationId = &waiter.StandbyDataGuardAssociationId
+	request.DatabaseAdminPassword = &password
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
+
+	if _, err := s.Client.SwitchoverDataGuardAssociation(context.Background(), request); err != nil {
+		return err
+	}
+
+	waiter.WantedRole, waiter.WantedPeerRoles = oci_database.DataGuardAssociationRolePrimary, []oci_database.DataGuardAssociationPeerRoleEnum{oci_database.DataGuardAssociationPeerRoleStandby}
+	return waitForStateRefresh(waiter, s.D.Timeout(schema.TimeoutUpdate), "switchover", waiter.Pending(), waiter.Target())
+}
+
 func (s *DatabaseDataGuardAssociationResourceCrud) Delete() error {
 	if deleteStandbyDbHomeOnDelete, ok := s.D.GetOkExists("delete_standby_db_home_on_delete"); ok {
 		tmp := deleteStandbyDbHomeOnDelete.(string)
@@ -422,6 +536,12 @@ func (s *DatabaseDataGuardAssociationResourceCrud) SetData() error {
 
 	s.D.Set("peer_role", s.Res.PeerRole)
 
+	if s.Res.Role == oci_database.DataGuardAssociationRolePrimary && s.Res.DatabaseId != nil {
+		s.D.Set("primary_database_id", *s.Res.DatabaseId)
+	} else if s.Res.PeerRole == oci_database.DataGuardAssociationPeerRolePrimary && s.Res.PeerDatabaseId != nil {
+		s.D.Set("primary_database_id", *s.Res.PeerDatabaseId)
+	}
+
 	s.D.Set("protection_mode", s.Res.ProtectionMode)
 
 	s.D.Set("role", s.Res.Role)
@@ -612,3 +732,120 @@ func waitForDbSystemToTerminateRetryPolicy(timeout time.Duration) *oci_common.Re
 		MaximumNumberAttempts: 0,
 	}
 }
+
+const (
+	dataGuardRoleTransitionSwitchover = "SWITCHOVER"
+	dataGuardRoleTransitionFailover   = "FAILOVER"
+
+	dataGuardRoleTransitionInProgress = "IN_PROGRESS"
+	dataGuardRoleTransitionSucceeded  = "SUCCEEDED"
+	dataGuardRoleTransitionFailed     = "FAILED"
+)
+
+// DatabaseDataGuardRoleTransitionCrud polls both databases of a data guard association until they have taken their wanted roles
+type DatabaseDataGuardRoleTransitionCrud struct {
+	BaseCrud
+	Client                        *oci_database.DatabaseClient
+	PrimaryDatabaseId             string
+	PrimaryDataGuardAssociationId string
+	StandbyDatabaseId             string
+	StandbyDataGuardAssociationId string
+	WantedRole                    oci_database.DataGuardAssociationRoleEnum
+	WantedPeerRoles               []oci_database.DataGuardAssociationPeerRoleEnum
+	Res                           *oci_database.DataGuardAssociation
+	PeerRes                       *oci_database.DataGuardAssociation
+	DisableNotFoundRetries        bool
+}
+
+func (s *DatabaseDataGuardRoleTransitionCrud) Get() error {
+	request := oci_database.GetDataGuardAssociationRequest{}
+	request.DatabaseId = &s.PrimaryDatabaseId
+	request.DataGuardAssociationId = &s.PrimaryDataGuardAssociationId
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
+
+	response, err := s.Client.GetDataGuardAssociation(context.Background(), request)
+	if err != nil {
+		return err
+	}
+	s.Res = &response.DataGuardAssociation
+
+	peerRequest := oci_database.GetDataGuardAssociationRequest{}
+	peerRequest.DatabaseId = &s.StandbyDatabaseId
+	peerRequest.DataGuardAssociationId = &s.StandbyDataGuardAssociationId
+	peerRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
+
+	peerResponse, err := s.Client.GetDataGuardAssociation(context.Background(), peerRequest)
+	if err != nil {
+		return err
+	}
+	s.PeerRes = &peerResponse.DataGuardAssociation
+
+	return nil
+}
+
+// The roles are tracked on the association, they are not the lifecycle state of the resource
+func (s *DatabaseDataGuardRoleTransitionCrud) setState(sync StatefulResource) error {
+	return nil
+}
+
+func (s *DatabaseDataGuardRoleTransitionCrud) State() string {
+	if s.Res.LifecycleState == oci_database.DataGuardAssociationLifecycleStateFailed || s.PeerRes.LifecycleState == oci_database.DataGuardAssociationLifecycleStateFailed {
+		return dataGuardRoleTransitionFailed
+	}
+
+	if s.Res.LifecycleState != oci_database.DataGuardAssociationLifecycleStateAvailable || s.Res.Role != s.WantedRole {
+		return dataGuardRoleTransitionInProgress
+	}
+
+	for _, peerRole := range s.WantedPeerRoles {
+		// The peer association describes the same pair of databases from the other side
+		if s.Res.PeerRole == peerRole && string(s.PeerRes.Role) == string(peerRole) {
+			return dataGuardRoleTransitionSucceeded
+		}
+	}
+
+	return dataGuardRoleTransitionInProgress
+}
+
+func (s *DatabaseDataGuardRoleTransitionCrud) SetData() error {
+	return nil
+}
+
+func (s *DatabaseDataGuardRoleTransitionCrud) Pending() []string {
+	return []string{dataGuardRoleTransitionInProgress}
+}
+
+func (s *DatabaseDataGuardRoleTransitionCrud) Target() []string {
+	return []string{dataGuardRoleTransitionSucceeded}
+}
+
+func importDatabaseDataGuardAssociation(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	databaseId, dataGuardAssociationId, err := parseDataGuardAssociationCompositeId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(dataGuardAssociationId)
+	err = d.Set("database_id", databaseId)
+	return []*schema.ResourceData{d}, err
+}
+
+func getDataGuardAssociationCompositeId(databaseId string, dataGuardAssociationId string) string {
+	databaseId = url.PathEscape(databaseId)
+	dataGuardAssociationId = url.PathEscape(dataGuardAssociationId)
+	compositeId := "databases/" + databaseId + "/dataGuardAssociations/" + dataGuardAssociationId
+	return compositeId
+}
+
+func parseDataGuardAssociationCompositeId(compositeId string) (databaseId string, dataGuardAssociationId string, err error) {
+	parts := strings.Split(compositeId, "/")
+	match, _ := regexp.MatchString("^databases/[^/]+/dataGuardAssociations/[^/]+$", compositeId)
+	if !match || len(parts) != 4 {
+		err = fmt.Errorf("illegal compositeId %s encountered, expected format: databases/{databaseId}/dataGuardAssociations/{dataGuardAssociationId}", compositeId)
+		return
+	}
+	databaseId, _ = url.PathUnescape(parts[1])
+	dataGuardAssociationId, _ = url.PathUnescape(parts[3])
+
+	return
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	oci_database "github.com/oracle/oci-go-sdk/database"
 
 	"github.com/terraform-providers/terraform-provider-oci/httpreplay"
 )
@@ -292,6 +293,89 @@ func TestDatabaseDataGuardAssociationResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(singularDatasourceName, "transport_type", "ASYNC"),
 				),
 			},
+			// remove singular datasource from previous step so that it doesn't conflict with import tests
+			{
+				Config: config + compartmentIdVariableStr + DataGuardAssociationResourceDependencies +
+					generateResourceFromRepresentationMap("oci_database_data_guard_association", "test_data_guard_association", Optional, Update, dataGuardAssociationRepresentationExistingDbSystem),
+			},
+			// verify resource import
+			{
+				Config:            config,
+				ImportStateIdFunc: getDataGuardAssociationImportId(resourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"availability_domain",
+					"backup_network_nsg_ids",
+					"creation_type",
+					"database_admin_password",
+					"delete_standby_db_home_on_delete",
+					"display_name",
+					"hostname",
+					"nsg_ids",
+					"role_transition",
+					"subnet_id",
+				},
+				ResourceName: resourceName,
+			},
 		},
 	})
 }
+
+func TestUnitDataGuardRoleTransitionState(t *testing.T) {
+	association := func(state oci_database.DataGuardAssociationLifecycleStateEnum, role oci_database.DataGuardAssociationRoleEnum, peerRole oci_database.DataGuardAssociationPeerRoleEnum) *oci_database.DataGuardAssociation {
+		return &oci_database.DataGuardAssociation{LifecycleState: state, Role: role, PeerRole: peerRole}
+	}
+	available := oci_database.DataGuardAssociationLifecycleStateAvailable
+
+	waiter := &DatabaseDataGuardRoleTransitionCrud{
+		WantedRole:      oci_database.DataGuardAssociationRolePrimary,
+		WantedPeerRoles: []oci_database.DataGuardAssociationPeerRoleEnum{oci_database.DataGuardAssociationPeerRoleStandby},
+	}
+
+	tests := []struct {
+		name     string
+		res      *oci_database.DataGuardAssociation
+		peerRes  *oci_database.DataGuardAssociation
+		expected string
+	}{
+		{"switchover not started",
+			association(available, oci_database.DataGuardAssociationRoleStandby, oci_database.DataGuardAssociationPeerRolePrimary),
+			association(available, oci_database.DataGuardAssociationRolePrimary, oci_database.DataGuardAssociationPeerRoleStandby),
+			dataGuardRoleTransitionInProgress},
+		{"association still updating",
+			association(oci_database.DataGuardAssociationLifecycleStateUpdating, oci_database.DataGuardAssociationRolePrimary, oci_database.DataGuardAssociationPeerRoleStandby),
+			association(available, oci_database.DataGuardAssociationRoleStandby, oci_database.DataGuardAssociationPeerRolePrimary),
+			dataGuardRoleTransitionInProgress},
+		{"peer has not caught up",
+			association(available, oci_database.DataGuardAssociationRolePrimary, oci_database.DataGuardAssociationPeerRoleStandby),
+			association(available, oci_database.DataGuardAssociationRolePrimary, oci_database.DataGuardAssociationPeerRoleStandby),
+			dataGuardRoleTransitionInProgress},
+		{"switchover succeeded",
+			association(available, oci_database.DataGuardAssociationRolePrimary, oci_database.DataGuardAssociationPeerRoleStandby),
+			association(available, oci_database.DataGuardAssociationRoleStandby, oci_database.DataGuardAssociationPeerRolePrimary),
+			dataGuardRoleTransitionSucceeded},
+		{"association failed",
+			association(available, oci_database.DataGuardAssociationRoleStandby, oci_database.DataGuardAssociationPeerRolePrimary),
+			association(oci_database.DataGuardAssociationLifecycleStateFailed, oci_database.DataGuardAssociationRolePrimary, oci_database.DataGuardAssociationPeerRoleStandby),
+			dataGuardRoleTransitionFailed},
+	}
+
+	for _, test := range tests {
+		waiter.Res, waiter.PeerRes = test.res, test.peerRes
+		if state := waiter.State(); state != test.expected {
+			t.Errorf("%s: expected state %s, got %s", test.name, test.expected, state)
+		}
+	}
+}
+
+func getDataGuardAssociationImportId(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return getDataGuardAssociationCompositeId(rs.Primary.Attributes["database_id"], rs.Primary.ID), nil
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 
+	oci_common "github.com/oracle/oci-go-sdk/common"
 	oci_database "github.com/oracle/oci-go-sdk/database"
 )
 
@@ -200,6 +201,8 @@ func DatabaseDbHomeResource() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
+			"patch_action":  databasePatchActionSchema(),
+			"patch_version": databasePatchVersionSchema(),
 			"source": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -230,6 +233,7 @@ func DatabaseDbHomeResource() *schema.Resource {
 				Computed: true,
 			},
 		},
+		CustomizeDiff: validateDatabasePatchOnCreate,
 	}
 }
 
@@ -411,6 +415,11 @@ func (s *DatabaseDbHomeResourceCrud) Update() error {
 	if err != nil {
 		log.Printf("[ERROR] error refreshing the dbHome information before an upate: %v", err)
 	}
+
+	if err := s.applyPatch(); err != nil {
+		restoreDatabasePatchDetails(s.D)
+		return err
+	}
 	if s.Database == nil || s.Database.Id == nil {
 		err := s.getDatabaseInfo()
 		if err != nil {
@@ -461,6 +470,103 @@ func (s *DatabaseDbHomeResourceCrud) Update() error {
 	return err
 }
 
+// applyPatch performs the patch action given by patch_version and patch_action, and waits for its patch history entry to finish
+func (s *DatabaseDbHomeResourceCrud) applyPatch() error {
+	version, action, ok, err := getDatabasePatchDetails(s.D)
+	if err != nil || !ok {
+		return err
+	}
+
+	dbHomeId := s.D.Id()
+
+	patchId, err := findDatabasePatchId(version, func(page *string) ([]oci_database.PatchSummary, *string, error) {
+		request := oci_database.ListDbHomePatchesRequest{DbHomeId: &dbHomeId, Page: page}
+		request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
+		response, err := s.Client.ListDbHomePatches(context.Background(), request)
+		return response.Items, response.OpcNextPage, err
+	})
+	if err != nil {
+		return err
+	}
+
+	getPatchRequest := oci_database.GetDbHomePatchRequest{DbHomeId: &dbHomeId, PatchId: &patchId}
+	getPatchRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
+	getPatchResponse, err := s.Client.GetDbHomePatch(context.Background(), getPatchRequest)
+	if err != nil {
+		return err
+	}
+	if err := validateDatabasePatchAction(getPatchResponse.Patch, action); err != nil {
+		return err
+	}
+
+	var previousPatchHistoryEntryId *string
+	if s.Res != nil {
+		previousPatchHistoryEntryId = s.Res.LastPatchHistoryEntryId
+	}
+
+	request := oci_database.UpdateDbHomeRequest{}
+	request.DbHomeId = &dbHomeId
+	request.DbVersion = &oci_database.PatchDetails{PatchId: &patchId, Action: action}
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
+
+	if _, err := s.Client.UpdateDbHome(context.Background(), request); err != nil {
+		return err
+	}
+
+	// The patch history entry of the action is only known once the dbHome reports it
+	patchHistoryEntryPending := func(response oci_common.OCIOperationResponse) bool {
+		if getDbHomeResponse, ok := response.Response.(oci_database.GetDbHomeResponse); ok {
+			return getDbHomeResponse.LastPatchHistoryEntryId == nil ||
+				(previousPatchHistoryEntryId != nil && *getDbHomeResponse.LastPatchHistoryEntryId == *previousPatchHistoryEntryId)
+		}
+		return false
+	}
+
+	getDbHomeRequest := oci_database.GetDbHomeRequest{DbHomeId: &dbHomeId}
+	getDbHomeRequest.RequestMetadata.RetryPolicy = getRetryPolicyWithAdditionalRetryCondition(s.D.Timeout(schema.TimeoutUpdate), patchHistoryEntryPending, "database")
+	getDbHomeResponse, err := s.Client.GetDbHome(context.Background(), getDbHomeRequest)
+	if err != nil {
+		return err
+	}
+	if patchHistoryEntryPending(oci_common.OCIOperationResponse{Response: getDbHomeResponse}) {
+		return fmt.Errorf("timed out waiting for the patch history entry of patch %s on dbHome %s", version, dbHomeId)
+	}
+
+	patchHistoryEntryInProgress := func(response oci_common.OCIOperationResponse) bool {
+		if getEntryResponse, ok := response.Response.(oci_database.GetDbHomePatchHistoryEntryResponse); ok {
+			return databasePatchHistoryEntryInProgress(getEntryResponse.PatchHistoryEntry)
+		}
+		return false
+	}
+
+	getEntryRequest := oci_database.GetDbHomePatchHistoryEntryRequest{DbHomeId: &dbHomeId, PatchHistoryEntryId: getDbHomeResponse.LastPatchHistoryEntryId}
+	getEntryRequest.RequestMetadata.RetryPolicy = getRetryPolicyWithAdditionalRetryCondition(s.D.Timeout(schema.TimeoutUpdate), patchHistoryEntryInProgress, "database")
+	getEntryResponse, err := s.Client.GetDbHomePatchHistoryEntry(context.Background(), getEntryRequest)
+	if err != nil {
+		return err
+	}
+	if err := databasePatchHistoryEntryError(getEntryResponse.PatchHistoryEntry); err != nil {
+		return err
+	}
+
+	// The dbHome stays in UPDATING for a short while after the patch history entry is done
+	dbHomeUpdating := func(response oci_common.OCIOperationResponse) bool {
+		if getDbHomeResponse, ok := response.Response.(oci_database.GetDbHomeResponse); ok {
+			return getDbHomeResponse.LifecycleState == oci_database.DbHomeLifecycleStateUpdating
+		}
+		return false
+	}
+
+	getDbHomeRequest.RequestMetadata.RetryPolicy = getRetryPolicyWithAdditionalRetryCondition(s.D.Timeout(schema.TimeoutUpdate), dbHomeUpdating, "database")
+	getDbHomeResponse, err = s.Client.GetDbHome(context.Background(), getDbHomeRequest)
+	if err != nil {
+		return err
+	}
+	s.Res = &getDbHomeResponse.DbHome
+
+	return nil
+}
+
 func (s *DatabaseDbHomeResourceCrud) Delete() error {
 	request := oci_database.DeleteDbHomeRequest{}
 
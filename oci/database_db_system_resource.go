@@ -368,6 +368,8 @@ func DatabaseDbSystemResource() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"patch_action":  databasePatchActionSchema(),
+			"patch_version": databasePatchVersionSchema(),
 			"source": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -496,6 +498,7 @@ func DatabaseDbSystemResource() *schema.Resource {
 				},
 			},
 		},
+		CustomizeDiff: validateDatabasePatchOnCreate,
 	}
 }
 
@@ -827,6 +830,11 @@ func (s *DatabaseDbSystemResourceCrud) Update() error {
 
 	s.Res = &getDbSystemResponse.DbSystem
 
+	if err := s.applyPatch(); err != nil {
+		restoreDatabasePatchDetails(s.D)
+		return err
+	}
+
 	err = s.SetData()
 	if err != nil {
 		return fmt.Errorf("[ERROR] error setting data after dbsystem update but before database update: %v", err)
@@ -835,6 +843,89 @@ func (s *DatabaseDbSystemResourceCrud) Update() error {
 	return s.UpdateDatabaseOperation()
 }
 
+// applyPatch performs the patch action given by patch_version and patch_action, and waits for its patch history entry to finish
+func (s *DatabaseDbSystemResourceCrud) applyPatch() error {
+	version, action, ok, err := getDatabasePatchDetails(s.D)
+	if err != nil || !ok {
+		return err
+	}
+
+	patchId, err := findDatabasePatchId(version, func(page *string) ([]oci_database.PatchSummary, *string, error) {
+		request := oci_database.ListDbSystemPatchesRequest{DbSystemId: s.Res.Id, Page: page}
+		request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
+		response, err := s.Client.ListDbSystemPatches(context.Background(), request)
+		return response.Items, response.OpcNextPage, err
+	})
+	if err != nil {
+		return err
+	}
+
+	getPatchRequest := oci_database.GetDbSystemPatchRequest{DbSystemId: s.Res.Id, PatchId: &patchId}
+	getPatchRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
+	getPatchResponse, err := s.Client.GetDbSystemPatch(context.Background(), getPatchRequest)
+	if err != nil {
+		return err
+	}
+	if err := validateDatabasePatchAction(getPatchResponse.Patch, action); err != nil {
+		return err
+	}
+
+	previousPatchHistoryEntryId := s.Res.LastPatchHistoryEntryId
+
+	request := oci_database.UpdateDbSystemRequest{}
+	request.DbSystemId = s.Res.Id
+	request.Version = &oci_database.PatchDetails{PatchId: &patchId, Action: action}
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
+
+	if _, err := s.Client.UpdateDbSystem(context.Background(), request); err != nil {
+		return err
+	}
+
+	// The patch history entry of the action is only known once the dbSystem reports it
+	patchHistoryEntryPending := func(response oci_common.OCIOperationResponse) bool {
+		if getDbSystemResponse, ok := response.Response.(oci_database.GetDbSystemResponse); ok {
+			return getDbSystemResponse.LastPatchHistoryEntryId == nil ||
+				(previousPatchHistoryEntryId != nil && *getDbSystemResponse.LastPatchHistoryEntryId == *previousPatchHistoryEntryId)
+		}
+		return false
+	}
+
+	getDbSystemRequest := oci_database.GetDbSystemRequest{DbSystemId: s.Res.Id}
+	getDbSystemRequest.RequestMetadata.RetryPolicy = getRetryPolicyWithAdditionalRetryCondition(s.D.Timeout(schema.TimeoutUpdate), patchHistoryEntryPending, "database")
+	getDbSystemResponse, err := s.Client.GetDbSystem(context.Background(), getDbSystemRequest)
+	if err != nil {
+		return err
+	}
+	if patchHistoryEntryPending(oci_common.OCIOperationResponse{Response: getDbSystemResponse}) {
+		return fmt.Errorf("timed out waiting for the patch history entry of patch %s on dbSystem %s", version, *s.Res.Id)
+	}
+
+	patchHistoryEntryInProgress := func(response oci_common.OCIOperationResponse) bool {
+		if getEntryResponse, ok := response.Response.(oci_database.GetDbSystemPatchHistoryEntryResponse); ok {
+			return databasePatchHistoryEntryInProgress(getEntryResponse.PatchHistoryEntry)
+		}
+		return false
+	}
+
+	getEntryRequest := oci_database.GetDbSystemPatchHistoryEntryRequest{DbSystemId: s.Res.Id, PatchHistoryEntryId: getDbSystemResponse.LastPatchHistoryEntryId}
+	getEntryRequest.RequestMetadata.RetryPolicy = getRetryPolicyWithAdditionalRetryCondition(s.D.Timeout(schema.TimeoutUpdate), patchHistoryEntryInProgress, "database")
+	getEntryResponse, err := s.Client.GetDbSystemPatchHistoryEntry(context.Background(), getEntryRequest)
+	if err != nil {
+		return err
+	}
+	if err := databasePatchHistoryEntryError(getEntryResponse.PatchHistoryEntry); err != nil {
+		return err
+	}
+
+	waitResponse, err := waitForDbSystemIfItIsUpdating(s.Res.Id, s.Client, s.D.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return err
+	}
+	s.Res = &waitResponse.DbSystem
+
+	return nil
+}
+
 func waitForDbSystemIfItIsUpdating(dbSystemID *string, client *oci_database.DatabaseClient, timeout time.Duration) (*oci_database.GetDbSystemResponse, error) {
 	getDbSystemRequest := oci_database.GetDbSystemRequest{}
 
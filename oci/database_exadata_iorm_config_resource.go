@@ -6,6 +6,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -16,6 +17,9 @@ import (
 
 func DatabaseExadataIormConfigResource() *schema.Resource {
 	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: importDatabaseExadataIormConfig,
+		},
 		Timeouts: DefaultTimeout,
 		Create:   createDatabaseExadataIormConfig,
 		Read:     readDatabaseExadataIormConfig,
@@ -328,3 +332,13 @@ func dbPlanHashCodeForSets(v interface{}) int {
 
 	return hashcode.String(buf.String())
 }
+
+func importDatabaseExadataIormConfig(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	dbSystemId := d.Id()
+	if !strings.HasPrefix(dbSystemId, "ocid1.dbsystem.") {
+		return nil, fmt.Errorf("id %s should be of format: {dbSystemId}", dbSystemId)
+	}
+
+	err := d.Set("db_system_id", dbSystemId)
+	return []*schema.ResourceData{d}, err
+}
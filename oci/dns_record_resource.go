@@ -12,6 +12,8 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
 
@@ -23,6 +25,9 @@ import (
 
 func DnsRecordResource() *schema.Resource {
 	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: importDnsRecord,
+		},
 		Timeouts: DefaultTimeout,
 		Create:   createDnsRecord,
 		Read:     readDnsRecord,
@@ -388,3 +393,71 @@ func normalizeRData(rtype, rdata string) string {
 
 	return rdata
 }
+
+// Records can be imported with an ID of the form zoneNameOrId/{zoneNameOrId}/domain/{domain}/rtype/{rtype}, which is
+// enough when the RRSet holds a single record, or with /recordHash/{recordHash} appended to select one record of the RRSet
+func importDnsRecord(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	zoneNameOrId, domain, rtype, recordHash, err := parseDnsRecordCompositeId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("zone_name_or_id", zoneNameOrId)
+	d.Set("domain", domain)
+	d.Set("rtype", rtype)
+
+	if recordHash == "" {
+		request := oci_dns.GetRRSetRequest{ZoneNameOrId: &zoneNameOrId, Domain: &domain, Rtype: &rtype}
+		request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "dns")
+
+		records := []oci_dns.Record{}
+		for {
+			response, err := m.(*OracleClients).dnsClient.GetRRSet(context.Background(), request)
+			if err != nil {
+				return nil, err
+			}
+			records = append(records, response.Items...)
+			if response.OpcNextPage == nil {
+				break
+			}
+			request.Page = response.OpcNextPage
+		}
+
+		if len(records) != 1 || records[0].RecordHash == nil {
+			return nil, fmt.Errorf("found %d %s records for domain %s in zone %s, add /recordHash/{recordHash} to the id to select one of them", len(records), rtype, domain, zoneNameOrId)
+		}
+		recordHash = *records[0].RecordHash
+	}
+
+	d.SetId(recordHash)
+	err = d.Set("record_hash", recordHash)
+	return []*schema.ResourceData{d}, err
+}
+
+func getDnsRecordCompositeId(zoneNameOrId string, domain string, rtype string, recordHash string) string {
+	zoneNameOrId = url.PathEscape(zoneNameOrId)
+	domain = url.PathEscape(domain)
+	rtype = url.PathEscape(rtype)
+	compositeId := "zoneNameOrId/" + zoneNameOrId + "/domain/" + domain + "/rtype/" + rtype
+	if recordHash != "" {
+		compositeId += "/recordHash/" + url.PathEscape(recordHash)
+	}
+	return compositeId
+}
+
+func parseDnsRecordCompositeId(compositeId string) (zoneNameOrId string, domain string, rtype string, recordHash string, err error) {
+	parts := strings.Split(compositeId, "/")
+	match, _ := regexp.MatchString("^zoneNameOrId/[^/]+/domain/[^/]+/rtype/[^/]+(/recordHash/[^/]+)?$", compositeId)
+	if !match || (len(parts) != 6 && len(parts) != 8) {
+		err = fmt.Errorf("illegal compositeId %s encountered, expected format: zoneNameOrId/{zoneNameOrId}/domain/{domain}/rtype/{rtype}[/recordHash/{recordHash}]", compositeId)
+		return
+	}
+	zoneNameOrId, _ = url.PathUnescape(parts[1])
+	domain, _ = url.PathUnescape(parts[3])
+	rtype, _ = url.PathUnescape(parts[5])
+	if len(parts) == 8 {
+		recordHash, _ = url.PathUnescape(parts[7])
+	}
+
+	return
+}
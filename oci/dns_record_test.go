@@ -147,10 +147,32 @@ func TestDnsRecordsResource_basic(t *testing.T) {
 					},
 				),
 			},
+			// verify resource import
+			{
+				Config:            config,
+				ImportStateIdFunc: getDnsRecordImportId(resourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"compartment_id",
+				},
+				ResourceName: resourceName,
+			},
 		},
 	})
 }
 
+func getDnsRecordImportId(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return getDnsRecordCompositeId(rs.Primary.Attributes["zone_name_or_id"], rs.Primary.Attributes["domain"], rs.Primary.Attributes["rtype"], rs.Primary.Attributes["record_hash"]), nil
+	}
+}
+
 // The datasource tests are kept separate from the previous test steps.
 // This was because the datasource steps do not create a record resource (and won't need one because, because a zone has default records).
 // If this was kept in the previous test case, the CheckDestroy step would run after the datasource steps ran and would fail
@@ -406,3 +428,22 @@ func testAccCheckDnsRecordDestroy(s *terraform.State) error {
 
 	return nil
 }
+
+func TestUnitParseDnsRecordCompositeId(t *testing.T) {
+	zoneNameOrId, domain, rtype, recordHash, err := parseDnsRecordCompositeId("zoneNameOrId/example.com/domain/www.example.com/rtype/A")
+	if err != nil || zoneNameOrId != "example.com" || domain != "www.example.com" || rtype != "A" || recordHash != "" {
+		t.Errorf("unexpected result %s %s %s %s: %v", zoneNameOrId, domain, rtype, recordHash, err)
+	}
+
+	compositeId := getDnsRecordCompositeId("ocid1.dns-zone.oc1..zone", "example.com", "TXT", "a1b2c3")
+	zoneNameOrId, domain, rtype, recordHash, err = parseDnsRecordCompositeId(compositeId)
+	if err != nil || zoneNameOrId != "ocid1.dns-zone.oc1..zone" || domain != "example.com" || rtype != "TXT" || recordHash != "a1b2c3" {
+		t.Errorf("unexpected result %s %s %s %s: %v", zoneNameOrId, domain, rtype, recordHash, err)
+	}
+
+	for _, invalidId := range []string{"", "a1b2c3", "example.com/www.example.com/A", "zoneNameOrId/example.com/domain/www.example.com", "zoneNameOrId/example.com/domain/www.example.com/rtype/A/recordHash/"} {
+		if _, _, _, _, err := parseDnsRecordCompositeId(invalidId); err == nil {
+			t.Errorf("expected an error for compositeId '%s'", invalidId)
+		}
+	}
+}
@@ -0,0 +1,302 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	oci_dns "github.com/oracle/oci-go-sdk/dns"
+)
+
+func DnsRrsetResource() *schema.Resource {
+	items := dnsRecordItemsSchema()
+	items.Optional = false
+	items.Required = true
+
+	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: importDnsRrset,
+		},
+		Timeouts: DefaultTimeout,
+		Create:   createDnsRrset,
+		Read:     readDnsRrset,
+		Update:   updateDnsRrset,
+		Delete:   deleteDnsRrset,
+		Schema: map[string]*schema.Schema{
+			// Required
+			"domain": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return normalizeDnsDomain(old) == normalizeDnsDomain(new)
+				},
+			},
+			"items": items,
+			"rtype": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"zone_name_or_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// Optional
+			"compartment_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			// Computed
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func createDnsRrset(d *schema.ResourceData, m interface{}) error {
+	sync := &DnsRrsetResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).dnsClient
+
+	return CreateResource(d, sync)
+}
+
+func readDnsRrset(d *schema.ResourceData, m interface{}) error {
+	sync := &DnsRrsetResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).dnsClient
+
+	return ReadResource(sync)
+}
+
+func updateDnsRrset(d *schema.ResourceData, m interface{}) error {
+	sync := &DnsRrsetResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).dnsClient
+
+	return UpdateResource(d, sync)
+}
+
+func deleteDnsRrset(d *schema.ResourceData, m interface{}) error {
+	sync := &DnsRrsetResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).dnsClient
+	sync.DisableNotFoundRetries = true
+
+	return DeleteResource(d, sync)
+}
+
+type DnsRrsetResourceCrud struct {
+	BaseCrud
+	Client                 *oci_dns.DnsClient
+	Res                    *[]oci_dns.Record
+	ETag                   *string
+	DisableNotFoundRetries bool
+}
+
+func (s *DnsRrsetResourceCrud) ID() string {
+	return getDnsRecordCompositeId(s.D.Get("zone_name_or_id").(string), s.D.Get("domain").(string), s.D.Get("rtype").(string), "")
+}
+
+func (s *DnsRrsetResourceCrud) Create() error {
+	if err := s.validateItems(); err != nil {
+		return err
+	}
+
+	request := oci_dns.UpdateRRSetRequest{}
+
+	zoneNameOrId := s.D.Get("zone_name_or_id").(string)
+	request.ZoneNameOrId = &zoneNameOrId
+
+	domain := s.D.Get("domain").(string)
+	request.Domain = &domain
+
+	rtype := s.D.Get("rtype").(string)
+	request.Rtype = &rtype
+
+	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
+		tmp := compartmentId.(string)
+		request.CompartmentId = &tmp
+	}
+
+	request.Items = dnsRecordDetailsFromSet(s.D.Get("items").(*schema.Set))
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "dns")
+	_, err := s.Client.UpdateRRSet(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	// UpdateRRSet only returns the first page of records, read the whole record set back along with its etag
+	return s.Get()
+}
+
+func (s *DnsRrsetResourceCrud) Get() error {
+	request := oci_dns.GetRRSetRequest{}
+
+	zoneNameOrId := s.D.Get("zone_name_or_id").(string)
+	request.ZoneNameOrId = &zoneNameOrId
+
+	domain := s.D.Get("domain").(string)
+	request.Domain = &domain
+
+	rtype := s.D.Get("rtype").(string)
+	request.Rtype = &rtype
+
+	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
+		tmp := compartmentId.(string)
+		request.CompartmentId = &tmp
+	}
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "dns")
+
+	items := []oci_dns.Record{}
+	for {
+		response, err := s.Client.GetRRSet(context.Background(), request)
+		if err != nil {
+			return err
+		}
+
+		if s.ETag == nil {
+			s.ETag = response.ETag
+		}
+		items = append(items, response.Items...)
+
+		if response.OpcNextPage == nil {
+			break
+		}
+		request.Page = response.OpcNextPage
+	}
+
+	// An empty record set no longer exists as far as terraform is concerned
+	if len(items) == 0 {
+		return fmt.Errorf("record set %s %s not found in zone %s", domain, rtype, zoneNameOrId)
+	}
+
+	s.Res = &items
+	return nil
+}
+
+func (s *DnsRrsetResourceCrud) Update() error {
+	if err := s.validateItems(); err != nil {
+		return err
+	}
+
+	request := oci_dns.PatchRRSetRequest{}
+
+	zoneNameOrId := s.D.Get("zone_name_or_id").(string)
+	request.ZoneNameOrId = &zoneNameOrId
+
+	domain := s.D.Get("domain").(string)
+	request.Domain = &domain
+
+	rtype := s.D.Get("rtype").(string)
+	request.Rtype = &rtype
+
+	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
+		tmp := compartmentId.(string)
+		request.CompartmentId = &tmp
+	}
+
+	// Only send the records that changed, and only if nobody else modified the record set since it was last read
+	o, n := s.D.GetChange("items")
+	request.Items = getDnsRecordOperations(o.(*schema.Set), n.(*schema.Set))
+
+	if etag, ok := s.D.GetOkExists("etag"); ok {
+		tmp := etag.(string)
+		request.IfMatch = &tmp
+	}
+
+	if len(request.Items) > 0 {
+		request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "dns")
+		_, err := s.Client.PatchRRSet(context.Background(), request)
+		if err != nil {
+			return err
+		}
+	}
+
+	return s.Get()
+}
+
+func (s *DnsRrsetResourceCrud) Delete() error {
+	request := oci_dns.DeleteRRSetRequest{}
+
+	zoneNameOrId := s.D.Get("zone_name_or_id").(string)
+	request.ZoneNameOrId = &zoneNameOrId
+
+	domain := s.D.Get("domain").(string)
+	request.Domain = &domain
+
+	rtype := s.D.Get("rtype").(string)
+	request.Rtype = &rtype
+
+	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
+		tmp := compartmentId.(string)
+		request.CompartmentId = &tmp
+	}
+
+	if etag, ok := s.D.GetOkExists("etag"); ok {
+		tmp := etag.(string)
+		request.IfMatch = &tmp
+	}
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "dns")
+	_, err := s.Client.DeleteRRSet(context.Background(), request)
+	return err
+}
+
+func (s *DnsRrsetResourceCrud) SetData() error {
+	if s.ETag != nil {
+		s.D.Set("etag", *s.ETag)
+	}
+
+	if err := s.D.Set("items", dnsRecordsToSet(*s.Res)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Every record in the set must belong to the record set's domain and rtype, the service would otherwise reject the request
+func (s *DnsRrsetResourceCrud) validateItems() error {
+	domain := s.D.Get("domain").(string)
+	rtype := s.D.Get("rtype").(string)
+
+	for _, item := range s.D.Get("items").(*schema.Set).List() {
+		m := item.(map[string]interface{})
+		if normalizeDnsDomain(m["domain"].(string)) != normalizeDnsDomain(domain) {
+			return fmt.Errorf("item domain %s does not match the record set domain %s", m["domain"], domain)
+		}
+		if !strings.EqualFold(m["rtype"].(string), rtype) {
+			return fmt.Errorf("item rtype %s does not match the record set rtype %s", m["rtype"], rtype)
+		}
+	}
+
+	return nil
+}
+
+func importDnsRrset(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	zoneNameOrId, domain, rtype, recordHash, err := parseDnsRecordCompositeId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	if recordHash != "" {
+		return nil, fmt.Errorf("illegal compositeId %s encountered, expected format: zoneNameOrId/{zoneNameOrId}/domain/{domain}/rtype/{rtype}", d.Id())
+	}
+
+	d.Set("zone_name_or_id", zoneNameOrId)
+	d.Set("domain", domain)
+	d.Set("rtype", rtype)
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,155 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	oci_dns "github.com/oracle/oci-go-sdk/dns"
+
+	"github.com/terraform-providers/terraform-provider-oci/httpreplay"
+)
+
+const (
+	RrsetResourceConfig = `
+resource "oci_dns_rrset" "test_rrset" {
+	zone_name_or_id = "${oci_dns_zone.test_zone.name}"
+	domain = "www.${oci_dns_zone.test_zone.name}"
+	rtype = "A"
+
+	items {
+		domain = "www.${oci_dns_zone.test_zone.name}"
+		rtype = "A"
+		rdata = "192.168.0.1"
+		ttl = 3600
+	}
+	items {
+		domain = "www.${oci_dns_zone.test_zone.name}"
+		rtype = "A"
+		rdata = "192.168.0.2"
+		ttl = 3600
+	}
+}
+`
+
+	RrsetResourceUpdateConfig = `
+resource "oci_dns_rrset" "test_rrset" {
+	zone_name_or_id = "${oci_dns_zone.test_zone.name}"
+	domain = "www.${oci_dns_zone.test_zone.name}"
+	rtype = "A"
+
+	items {
+		domain = "www.${oci_dns_zone.test_zone.name}"
+		rtype = "A"
+		rdata = "192.168.0.1"
+		ttl = 3600
+	}
+	items {
+		domain = "www.${oci_dns_zone.test_zone.name}"
+		rtype = "A"
+		rdata = "77.77.77.77"
+		ttl = 1000
+	}
+}
+`
+)
+
+func TestDnsRrsetResource_basic(t *testing.T) {
+	httpreplay.SetScenario("TestDnsRrsetResource_basic")
+	defer httpreplay.SaveScenario()
+
+	provider := testAccProvider
+	config := testProviderConfig()
+
+	compartmentId := getEnvSettingWithBlankDefault("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+	resourceName := "oci_dns_rrset.test_rrset"
+
+	_, tokenFn := tokenizeWithHttpReplay("dns_rrset_resource")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Providers: map[string]terraform.ResourceProvider{
+			"oci": provider,
+		},
+		CheckDestroy: testAccCheckDnsRrsetDestroy,
+		Steps: []resource.TestStep{
+			// verify create
+			{
+				Config: tokenFn(config+compartmentIdVariableStr+RecordResourceDependencies+RrsetResourceConfig, nil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rtype", "A"),
+					resource.TestCheckResourceAttr(resourceName, "items.#", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "etag"),
+					TestCheckResourceAttributesEqual(resourceName, "zone_name_or_id", "oci_dns_zone.test_zone", "name"),
+				),
+			},
+
+			// verify updates only patch the changed record
+			{
+				Config: tokenFn(config+compartmentIdVariableStr+RecordResourceDependencies+RrsetResourceUpdateConfig, nil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rtype", "A"),
+					resource.TestCheckResourceAttr(resourceName, "items.#", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "etag"),
+				),
+			},
+			// verify resource import
+			{
+				Config:            config,
+				ImportStateIdFunc: getDnsRrsetImportId(resourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+				ResourceName:      resourceName,
+			},
+		},
+	})
+}
+
+func getDnsRrsetImportId(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return getDnsRecordCompositeId(rs.Primary.Attributes["zone_name_or_id"], rs.Primary.Attributes["domain"], rs.Primary.Attributes["rtype"], ""), nil
+	}
+}
+
+func testAccCheckDnsRrsetDestroy(s *terraform.State) error {
+	noResourceFound := true
+	client := testAccProvider.Meta().(*OracleClients).dnsClient
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type == "oci_dns_rrset" {
+			noResourceFound = false
+			request := oci_dns.GetRRSetRequest{}
+
+			if value, ok := rs.Primary.Attributes["zone_name_or_id"]; ok {
+				request.ZoneNameOrId = &value
+			}
+
+			if value, ok := rs.Primary.Attributes["domain"]; ok {
+				request.Domain = &value
+			}
+
+			if value, ok := rs.Primary.Attributes["rtype"]; ok {
+				request.Rtype = &value
+			}
+
+			response, err := client.GetRRSet(context.Background(), request)
+			if err == nil && len(response.Items) > 0 {
+				return fmt.Errorf("record set still exists")
+			}
+		}
+	}
+	if noResourceFound {
+		return fmt.Errorf("at least one resource was expected from the state file, but could not be found")
+	}
+
+	return nil
+}
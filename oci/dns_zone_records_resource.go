@@ -0,0 +1,240 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+/*
+ A note to maintainers: this resource is authoritative for every record of a zone that is not protected. Protected records
+ (the SOA record and the NS records at the zone apex) are managed by the service, they are never read into "items" and are
+ always sent back unchanged when the zone's records are replaced.
+*/
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	oci_dns "github.com/oracle/oci-go-sdk/dns"
+)
+
+func DnsZoneRecordsResource() *schema.Resource {
+	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: DefaultTimeout,
+		Create:   createDnsZoneRecords,
+		Read:     readDnsZoneRecords,
+		Update:   updateDnsZoneRecords,
+		Delete:   deleteDnsZoneRecords,
+		Schema: map[string]*schema.Schema{
+			// Required
+			"zone_name_or_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// Optional
+			"compartment_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"items": dnsRecordItemsSchema(),
+
+			// Computed
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func createDnsZoneRecords(d *schema.ResourceData, m interface{}) error {
+	sync := &DnsZoneRecordsResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).dnsClient
+
+	return CreateResource(d, sync)
+}
+
+func readDnsZoneRecords(d *schema.ResourceData, m interface{}) error {
+	sync := &DnsZoneRecordsResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).dnsClient
+
+	return ReadResource(sync)
+}
+
+func updateDnsZoneRecords(d *schema.ResourceData, m interface{}) error {
+	sync := &DnsZoneRecordsResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).dnsClient
+
+	return UpdateResource(d, sync)
+}
+
+func deleteDnsZoneRecords(d *schema.ResourceData, m interface{}) error {
+	sync := &DnsZoneRecordsResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).dnsClient
+	sync.DisableNotFoundRetries = true
+
+	return DeleteResource(d, sync)
+}
+
+type DnsZoneRecordsResourceCrud struct {
+	BaseCrud
+	Client                 *oci_dns.DnsClient
+	Res                    *[]oci_dns.Record
+	ProtectedRecords       []oci_dns.Record
+	ETag                   *string
+	DisableNotFoundRetries bool
+}
+
+func (s *DnsZoneRecordsResourceCrud) ID() string {
+	return s.D.Get("zone_name_or_id").(string)
+}
+
+func (s *DnsZoneRecordsResourceCrud) Create() error {
+	// The protected records have to be part of the replacement, read them first along with the zone's etag
+	if err := s.Get(); err != nil {
+		return err
+	}
+
+	request := oci_dns.UpdateZoneRecordsRequest{}
+
+	zoneNameOrId := s.D.Get("zone_name_or_id").(string)
+	request.ZoneNameOrId = &zoneNameOrId
+
+	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
+		tmp := compartmentId.(string)
+		request.CompartmentId = &tmp
+	}
+
+	items := []oci_dns.RecordDetails{}
+	for _, record := range s.ProtectedRecords {
+		items = append(items, oci_dns.RecordDetails{
+			Domain:      record.Domain,
+			Rdata:       record.Rdata,
+			Rtype:       record.Rtype,
+			Ttl:         record.Ttl,
+			RecordHash:  record.RecordHash,
+			IsProtected: record.IsProtected,
+		})
+	}
+	if set, ok := s.D.Get("items").(*schema.Set); ok {
+		items = append(items, dnsRecordDetailsFromSet(set)...)
+	}
+	request.Items = items
+
+	request.IfMatch = s.ETag
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "dns")
+	_, err := s.Client.UpdateZoneRecords(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	// UpdateZoneRecords only returns the first page of records, read all of them back along with the new etag
+	s.ETag = nil
+	return s.Get()
+}
+
+func (s *DnsZoneRecordsResourceCrud) Get() error {
+	request := oci_dns.GetZoneRecordsRequest{}
+
+	zoneNameOrId := s.D.Get("zone_name_or_id").(string)
+	request.ZoneNameOrId = &zoneNameOrId
+
+	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
+		tmp := compartmentId.(string)
+		request.CompartmentId = &tmp
+	}
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "dns")
+
+	items := []oci_dns.Record{}
+	protectedItems := []oci_dns.Record{}
+	for {
+		response, err := s.Client.GetZoneRecords(context.Background(), request)
+		if err != nil {
+			return err
+		}
+
+		if s.ETag == nil {
+			s.ETag = response.ETag
+		}
+		for _, item := range response.Items {
+			if item.IsProtected != nil && *item.IsProtected {
+				protectedItems = append(protectedItems, item)
+			} else {
+				items = append(items, item)
+			}
+		}
+
+		if response.OpcNextPage == nil {
+			break
+		}
+		request.Page = response.OpcNextPage
+	}
+
+	s.Res = &items
+	s.ProtectedRecords = protectedItems
+	return nil
+}
+
+func (s *DnsZoneRecordsResourceCrud) Update() error {
+	o, n := s.D.GetChange("items")
+	if err := s.patch(getDnsRecordOperations(o.(*schema.Set), n.(*schema.Set))); err != nil {
+		return err
+	}
+
+	return s.Get()
+}
+
+func (s *DnsZoneRecordsResourceCrud) Delete() error {
+	o, _ := s.D.GetChange("items")
+	return s.patch(getDnsRecordOperations(o.(*schema.Set), schema.NewSet(dnsRecordItemHashCodeForSets, nil)))
+}
+
+func (s *DnsZoneRecordsResourceCrud) SetData() error {
+	if s.ETag != nil {
+		s.D.Set("etag", *s.ETag)
+	}
+
+	if err := s.D.Set("items", dnsRecordsToSet(*s.Res)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// patch only sends the records that changed, and only if nobody else modified the zone since it was last read
+func (s *DnsZoneRecordsResourceCrud) patch(operations []oci_dns.RecordOperation) error {
+	if len(operations) == 0 {
+		return nil
+	}
+
+	request := oci_dns.PatchZoneRecordsRequest{}
+
+	zoneNameOrId := s.D.Get("zone_name_or_id").(string)
+	request.ZoneNameOrId = &zoneNameOrId
+
+	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
+		tmp := compartmentId.(string)
+		request.CompartmentId = &tmp
+	}
+
+	if etag, ok := s.D.GetOkExists("etag"); ok {
+		tmp := etag.(string)
+		request.IfMatch = &tmp
+	}
+
+	request.Items = operations
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "dns")
+	_, err := s.Client.PatchZoneRecords(context.Background(), request)
+	return err
+}
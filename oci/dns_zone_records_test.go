@@ -0,0 +1,101 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/terraform-providers/terraform-provider-oci/httpreplay"
+)
+
+const (
+	ZoneRecordsResourceConfig = `
+resource "oci_dns_zone_records" "test_zone_records" {
+	zone_name_or_id = "${oci_dns_zone.test_zone.name}"
+
+	items {
+		domain = "www.${oci_dns_zone.test_zone.name}"
+		rtype = "A"
+		rdata = "192.168.0.1"
+		ttl = 3600
+	}
+	items {
+		domain = "mail.${oci_dns_zone.test_zone.name}"
+		rtype = "CNAME"
+		rdata = "www.${oci_dns_zone.test_zone.name}"
+		ttl = 3600
+	}
+}
+`
+
+	ZoneRecordsResourceUpdateConfig = `
+resource "oci_dns_zone_records" "test_zone_records" {
+	zone_name_or_id = "${oci_dns_zone.test_zone.name}"
+
+	items {
+		domain = "www.${oci_dns_zone.test_zone.name}"
+		rtype = "A"
+		rdata = "77.77.77.77"
+		ttl = 3600
+	}
+	items {
+		domain = "mail.${oci_dns_zone.test_zone.name}"
+		rtype = "CNAME"
+		rdata = "www.${oci_dns_zone.test_zone.name}"
+		ttl = 3600
+	}
+}
+`
+)
+
+func TestDnsZoneRecordsResource_basic(t *testing.T) {
+	httpreplay.SetScenario("TestDnsZoneRecordsResource_basic")
+	defer httpreplay.SaveScenario()
+
+	provider := testAccProvider
+	config := testProviderConfig()
+
+	compartmentId := getEnvSettingWithBlankDefault("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+	resourceName := "oci_dns_zone_records.test_zone_records"
+
+	_, tokenFn := tokenizeWithHttpReplay("dns_zone_records_resource")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Providers: map[string]terraform.ResourceProvider{
+			"oci": provider,
+		},
+		Steps: []resource.TestStep{
+			// verify create, protected records are not part of the resource
+			{
+				Config: tokenFn(config+compartmentIdVariableStr+RecordResourceDependencies+ZoneRecordsResourceConfig, nil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "items.#", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "etag"),
+					TestCheckResourceAttributesEqual(resourceName, "zone_name_or_id", "oci_dns_zone.test_zone", "name"),
+				),
+			},
+
+			// verify updates only patch the changed record
+			{
+				Config: tokenFn(config+compartmentIdVariableStr+RecordResourceDependencies+ZoneRecordsResourceUpdateConfig, nil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "items.#", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "etag"),
+				),
+			},
+			// verify resource import
+			{
+				Config:            config,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ResourceName:      resourceName,
+			},
+		},
+	})
+}
@@ -0,0 +1,341 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const (
+	exportStateFileName     = "terraform.tfstate"
+	exportVariablesFileName = "vars.tf"
+	exportProviderName      = "provider.oci"
+)
+
+// Lifecycle states of discovered resources that should not be exported
+var exportSkippedStates = map[string]bool{
+	"DELETED":     true,
+	"DELETING":    true,
+	"FAILED":      true,
+	"TERMINATED":  true,
+	"TERMINATING": true,
+}
+
+// ExportCommandArgs holds the arguments of the `export` command
+type ExportCommandArgs struct {
+	CompartmentId *string
+	OutputDir     *string
+	Services      []string
+}
+
+// OCIResource is a resource discovered in the compartment being exported
+type OCIResource struct {
+	terraformClass string
+	terraformName  string
+	ocid           string
+	parent         *OCIResource
+	hints          *TerraformResourceHints
+
+	// Populated by the Read() of the resource, which calls the SetData() of its ResourceCrud
+	data *schema.ResourceData
+
+	// References to other exported resources that this resource depends on
+	dependencies []string
+}
+
+func (r *OCIResource) getTerraformReference() string {
+	return fmt.Sprintf("%s.%s", r.terraformClass, r.terraformName)
+}
+
+func (r *OCIResource) getAttribute(attributeName string) string {
+	if attributeName == "id" {
+		return r.ocid
+	}
+	if value, ok := r.data.GetOk(attributeName); ok {
+		if str, ok := value.(string); ok {
+			return str
+		}
+	}
+	return ""
+}
+
+type resourceDiscoveryContext struct {
+	clients         *OracleClients
+	compartmentId   string
+	region          string
+	resourcesByOcid map[string]*OCIResource
+	terraformNames  map[string]bool
+	errorList       []error
+}
+
+// RunExportCommand discovers the resources in a compartment and writes them out as Terraform configuration and state
+func RunExportCommand(args *ExportCommandArgs) error {
+	if args.CompartmentId == nil || *args.CompartmentId == "" {
+		return fmt.Errorf("[ERROR] no compartment_id was specified for the export")
+	}
+
+	if args.OutputDir == nil || *args.OutputDir == "" {
+		return fmt.Errorf("[ERROR] no output_path was specified for the export")
+	}
+
+	services, err := getExportServices(args.Services)
+	if err != nil {
+		return err
+	}
+
+	outputDir, err := filepath.Abs(*args.OutputDir)
+	if err != nil {
+		return fmt.Errorf("[ERROR] can't form absolute path of %s: %v", *args.OutputDir, err)
+	}
+	if err := os.MkdirAll(outputDir, 0755); err != nil {
+		return fmt.Errorf("[ERROR] can't create output directory %s: %v", outputDir, err)
+	}
+
+	clients, err := getExportClients()
+	if err != nil {
+		return err
+	}
+
+	ctx := newResourceDiscoveryContext(clients, *args.CompartmentId)
+	if region, err := (*clients.identityClient.ConfigurationProvider()).Region(); err == nil {
+		ctx.region = region
+	}
+
+	initDependencyGraph()
+
+	discoveredResources := map[string][]*OCIResource{}
+	for _, service := range services {
+		log.Printf("[INFO] discovering %s resources in compartment '%s'", service, ctx.compartmentId)
+		root := &OCIResource{terraformClass: exportCompartmentRootClass, ocid: ctx.compartmentId}
+		discoveredResources[service] = ctx.discoverResources(root, compartmentResourceGraphs[service])
+	}
+
+	state := terraform.NewState()
+	rootModule := state.RootModule()
+	for _, service := range services {
+		resources := discoveredResources[service]
+		if len(resources) == 0 {
+			continue
+		}
+
+		var config strings.Builder
+		for _, resource := range resources {
+			hcl, err := ctx.getHCLString(resource)
+			if err != nil {
+				ctx.errorList = append(ctx.errorList, fmt.Errorf("[ERROR] unable to generate configuration for '%s': %v", resource.ocid, err))
+				continue
+			}
+			config.WriteString(hcl)
+			config.WriteString("\n")
+
+			rootModule.Resources[resource.getTerraformReference()] = &terraform.ResourceState{
+				Type:         resource.terraformClass,
+				Dependencies: resource.dependencies,
+				Primary:      resource.data.State(),
+				Provider:     exportProviderName,
+			}
+		}
+
+		configFile := filepath.Join(outputDir, fmt.Sprintf("%s.tf", service))
+		if err := ioutil.WriteFile(configFile, []byte(config.String()), 0644); err != nil {
+			return fmt.Errorf("[ERROR] can't write configuration to %s: %v", configFile, err)
+		}
+		log.Printf("[INFO] wrote %d %s resources to %s", len(resources), service, configFile)
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(outputDir, exportVariablesFileName), []byte(ctx.getVariablesHCLString()), 0644); err != nil {
+		return fmt.Errorf("[ERROR] can't write variables to %s: %v", exportVariablesFileName, err)
+	}
+
+	if err := writeExportState(state, filepath.Join(outputDir, exportStateFileName)); err != nil {
+		return err
+	}
+
+	for _, err := range ctx.errorList {
+		log.Printf("[WARN] %v", err)
+	}
+	log.Printf("[INFO] exported %d resources from compartment '%s' to %s", len(rootModule.Resources), ctx.compartmentId, outputDir)
+
+	return nil
+}
+
+func getExportServices(requestedServices []string) ([]string, error) {
+	services := []string{}
+	if len(requestedServices) == 0 {
+		for service := range compartmentResourceGraphs {
+			services = append(services, service)
+		}
+	} else {
+		for _, service := range requestedServices {
+			service = strings.TrimSpace(service)
+			if service == "" {
+				continue
+			}
+			if _, ok := compartmentResourceGraphs[service]; !ok {
+				return nil, fmt.Errorf("[ERROR] export of service '%s' is not supported", service)
+			}
+			services = append(services, service)
+		}
+	}
+	sort.Strings(services)
+	return services, nil
+}
+
+// getExportClients configures the provider from the environment, the same way Terraform does for an empty provider block
+func getExportClients() (*OracleClients, error) {
+	p := Provider(ProviderConfig).(*schema.Provider)
+	config := &terraform.ResourceConfig{
+		Raw:    map[string]interface{}{},
+		Config: map[string]interface{}{},
+	}
+	if err := p.Configure(config); err != nil {
+		return nil, err
+	}
+
+	clients, ok := p.Meta().(*OracleClients)
+	if !ok {
+		return nil, fmt.Errorf("[ERROR] unable to configure the OCI clients")
+	}
+	return clients, nil
+}
+
+func newResourceDiscoveryContext(clients *OracleClients, compartmentId string) *resourceDiscoveryContext {
+	return &resourceDiscoveryContext{
+		clients:         clients,
+		compartmentId:   compartmentId,
+		resourcesByOcid: map[string]*OCIResource{},
+		terraformNames:  map[string]bool{},
+	}
+}
+
+func (ctx *resourceDiscoveryContext) discoverResources(parent *OCIResource, graph TerraformResourceGraph) []*OCIResource {
+	result := []*OCIResource{}
+
+	for _, association := range graph[parent.terraformClass] {
+		foundResources, err := ctx.findResources(parent, association)
+		if err != nil {
+			ctx.errorList = append(ctx.errorList, fmt.Errorf("[ERROR] unable to discover %s resources: %v", association.resourceClass, err))
+			continue
+		}
+
+		for _, resource := range foundResources {
+			result = append(result, resource)
+			result = append(result, ctx.discoverResources(resource, graph)...)
+		}
+	}
+
+	return result
+}
+
+// findResources lists the resources of an associated type with its plural data source and reads each of them with the resource's own Read()
+func (ctx *resourceDiscoveryContext) findResources(parent *OCIResource, association TerraformResourceAssociation) ([]*OCIResource, error) {
+	dataSource, ok := dataSourcesMap()[association.datasourceClass]
+	if !ok {
+		return nil, fmt.Errorf("data source '%s' does not exist", association.datasourceClass)
+	}
+
+	d := dataSource.Data(nil)
+	if err := d.Set("compartment_id", ctx.compartmentId); err != nil {
+		return nil, err
+	}
+	for argument, parentAttribute := range association.datasourceQueryParams {
+		if err := d.Set(argument, parent.getAttribute(parentAttribute)); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := dataSource.Read(d, ctx.clients); err != nil {
+		return nil, err
+	}
+
+	excludedIds := map[string]bool{}
+	for _, attribute := range association.excludedParentAttributes {
+		excludedIds[parent.getAttribute(attribute)] = true
+	}
+
+	result := []*OCIResource{}
+	items, _ := d.Get(association.datasourceItemsAttr).([]interface{})
+	for _, item := range items {
+		itemMap, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		ocid, _ := itemMap["id"].(string)
+		if ocid == "" || excludedIds[ocid] || ctx.resourcesByOcid[ocid] != nil {
+			continue
+		}
+
+		if state, ok := itemMap["state"].(string); ok && exportSkippedStates[strings.ToUpper(state)] {
+			continue
+		}
+
+		// Some list operations also return resources from other compartments, or without any compartment, e.g. platform images
+		if compartmentId, ok := itemMap["compartment_id"].(string); ok && compartmentId != ctx.compartmentId {
+			continue
+		}
+
+		resource, err := ctx.readResource(association.TerraformResourceHints, ocid, parent)
+		if err != nil {
+			ctx.errorList = append(ctx.errorList, fmt.Errorf("[ERROR] unable to read %s '%s': %v", association.resourceClass, ocid, err))
+			continue
+		}
+		if resource == nil {
+			continue
+		}
+
+		resource.terraformName = ctx.generateTerraformName(resource.terraformClass, getExportDisplayName(itemMap))
+		ctx.resourcesByOcid[ocid] = resource
+		result = append(result, resource)
+	}
+
+	return result, nil
+}
+
+func (ctx *resourceDiscoveryContext) readResource(hints *TerraformResourceHints, ocid string, parent *OCIResource) (*OCIResource, error) {
+	resourceSchema, ok := resourcesMap()[hints.resourceClass]
+	if !ok {
+		return nil, fmt.Errorf("resource '%s' does not exist", hints.resourceClass)
+	}
+
+	d := resourceSchema.Data(nil)
+	d.SetId(ocid)
+	if err := resourceSchema.Read(d, ctx.clients); err != nil {
+		return nil, err
+	}
+
+	// The resource was voided by ReadResource() because it no longer exists
+	if d.Id() == "" {
+		return nil, nil
+	}
+
+	return &OCIResource{
+		terraformClass: hints.resourceClass,
+		ocid:           d.Id(),
+		parent:         parent,
+		hints:          hints,
+		data:           d,
+	}, nil
+}
+
+func writeExportState(state *terraform.State, path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("[ERROR] can't create state file %s: %v", path, err)
+	}
+	defer f.Close()
+
+	if err := terraform.WriteState(state, f); err != nil {
+		return fmt.Errorf("[ERROR] can't write state file %s: %v", path, err)
+	}
+	return nil
+}
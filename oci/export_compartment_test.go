@@ -0,0 +1,177 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitExportResourceHints_valid(t *testing.T) {
+	resources := resourcesMap()
+	dataSources := dataSourcesMap()
+
+	for service, graph := range compartmentResourceGraphs {
+		for parentClass, associations := range graph {
+			if parentClass != exportCompartmentRootClass {
+				_, ok := resources[parentClass]
+				assert.True(t, ok, "service '%s' has unknown parent resource '%s'", service, parentClass)
+			}
+
+			for _, association := range associations {
+				_, ok := resources[association.resourceClass]
+				assert.True(t, ok, "service '%s' exports unknown resource '%s'", service, association.resourceClass)
+
+				dataSource, ok := dataSources[association.datasourceClass]
+				if !assert.True(t, ok, "resource '%s' is listed with unknown data source '%s'", association.resourceClass, association.datasourceClass) {
+					continue
+				}
+
+				_, ok = dataSource.Schema["compartment_id"]
+				assert.True(t, ok, "data source '%s' has no compartment_id argument", association.datasourceClass)
+				_, ok = dataSource.Schema[association.datasourceItemsAttr]
+				assert.True(t, ok, "data source '%s' has no '%s' attribute", association.datasourceClass, association.datasourceItemsAttr)
+				for argument := range association.datasourceQueryParams {
+					_, ok = dataSource.Schema[argument]
+					assert.True(t, ok, "data source '%s' has no '%s' argument", association.datasourceClass, argument)
+				}
+			}
+		}
+	}
+}
+
+func TestUnitGetExportServices(t *testing.T) {
+	services, err := getExportServices(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, len(compartmentResourceGraphs), len(services))
+
+	services, err = getExportServices([]string{"database", " core"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"core", "database"}, services)
+
+	_, err = getExportServices([]string{"core", "unknown"})
+	assert.Error(t, err)
+}
+
+func TestUnitGetReferenceAttributes(t *testing.T) {
+	initDependencyGraph()
+
+	subnetReferences := getReferenceAttributes(exportCoreSubnetHints)
+	assert.True(t, subnetReferences["vcn_id"])
+	assert.True(t, subnetReferences["route_table_id"])
+	assert.True(t, subnetReferences["dhcp_options_id"])
+	assert.False(t, subnetReferences["compartment_id"])
+
+	assert.True(t, isReferenceAttribute("security_list_ids", subnetReferences))
+	assert.True(t, isReferenceAttribute("network_entity_id", nil))
+	assert.False(t, isReferenceAttribute("compartment_id", nil))
+	assert.False(t, isReferenceAttribute("display_name", nil))
+
+	ipsecReferences := getReferenceAttributes(exportCoreIpSecConnectionHints)
+	assert.True(t, ipsecReferences["drg_id"])
+	assert.True(t, ipsecReferences["cpe_id"])
+}
+
+func TestUnitGenerateTerraformName(t *testing.T) {
+	ctx := newResourceDiscoveryContext(nil, "ocid1.compartment.oc1..test")
+
+	assert.Equal(t, "export_my_vcn", ctx.generateTerraformName("oci_core_vcn", "my vcn"))
+	assert.Equal(t, "export_my_vcn_1", ctx.generateTerraformName("oci_core_vcn", "my vcn"))
+	assert.Equal(t, "export_my_vcn", ctx.generateTerraformName("oci_core_subnet", "my vcn"))
+	assert.Equal(t, "export_core_subnet", ctx.generateTerraformName("oci_core_subnet", ""))
+}
+
+func TestUnitGetHCLString(t *testing.T) {
+	initDependencyGraph()
+	compartmentId := "ocid1.compartment.oc1..test"
+	ctx := newResourceDiscoveryContext(nil, compartmentId)
+
+	vcn := &OCIResource{
+		terraformClass: "oci_core_vcn",
+		terraformName:  "export_vcn",
+		ocid:           "ocid1.vcn.oc1..test",
+		hints:          exportCoreVcnHints,
+		data:           CoreVcnResource().Data(nil),
+	}
+	ctx.resourcesByOcid[vcn.ocid] = vcn
+
+	securityList := &OCIResource{
+		terraformClass: "oci_core_security_list",
+		terraformName:  "export_security_list",
+		ocid:           "ocid1.securitylist.oc1..test",
+		hints:          exportCoreSecurityListHints,
+		data:           CoreSecurityListResource().Data(nil),
+	}
+	ctx.resourcesByOcid[securityList.ocid] = securityList
+
+	subnetData := CoreSubnetResource().Data(nil)
+	subnetData.SetId("ocid1.subnet.oc1..test")
+	subnetData.Set("cidr_block", "10.0.0.0/24")
+	subnetData.Set("compartment_id", compartmentId)
+	subnetData.Set("vcn_id", vcn.ocid)
+	subnetData.Set("display_name", "subnet \"${quoted}\"")
+	subnetData.Set("prohibit_public_ip_on_vnic", true)
+	subnetData.Set("security_list_ids", []interface{}{securityList.ocid, "ocid1.securitylist.oc1..other"})
+	subnetData.Set("freeform_tags", map[string]interface{}{"Department": "Finance"})
+	subnetData.Set("state", "AVAILABLE")
+
+	subnet := &OCIResource{
+		terraformClass: "oci_core_subnet",
+		terraformName:  "export_subnet",
+		ocid:           subnetData.Id(),
+		hints:          exportCoreSubnetHints,
+		data:           subnetData,
+	}
+
+	hcl, err := ctx.getHCLString(subnet)
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(hcl, `resource "oci_core_subnet" "export_subnet" {`))
+	assert.Contains(t, hcl, `  cidr_block = "10.0.0.0/24"`)
+	assert.Contains(t, hcl, `  compartment_id = "${var.compartment_ocid}"`)
+	assert.Contains(t, hcl, `  vcn_id = "${oci_core_vcn.export_vcn.id}"`)
+	assert.Contains(t, hcl, `  display_name = "subnet \"$${quoted}\""`)
+	assert.Contains(t, hcl, `  prohibit_public_ip_on_vnic = true`)
+	assert.Contains(t, hcl, `  security_list_ids = ["ocid1.securitylist.oc1..other", "${oci_core_security_list.export_security_list.id}"]`)
+	assert.Contains(t, hcl, "  freeform_tags = {\n    \"Department\" = \"Finance\"\n  }")
+	assert.NotContains(t, hcl, "state")
+	assert.Equal(t, []string{"oci_core_security_list.export_security_list", "oci_core_vcn.export_vcn"}, subnet.dependencies)
+}
+
+func TestUnitGetHCLString_nestedReferences(t *testing.T) {
+	initDependencyGraph()
+	compartmentId := "ocid1.compartment.oc1..test"
+	ctx := newResourceDiscoveryContext(nil, compartmentId)
+
+	internetGateway := &OCIResource{
+		terraformClass: "oci_core_internet_gateway",
+		terraformName:  "export_internet_gateway",
+		ocid:           "ocid1.internetgateway.oc1..test",
+		hints:          exportCoreInternetGatewayHints,
+		data:           CoreInternetGatewayResource().Data(nil),
+	}
+	ctx.resourcesByOcid[internetGateway.ocid] = internetGateway
+
+	routeTableData := CoreRouteTableResource().Data(nil)
+	routeTableData.SetId("ocid1.routetable.oc1..test")
+	routeTableData.Set("compartment_id", compartmentId)
+	routeTableData.Set("vcn_id", "ocid1.vcn.oc1..test")
+	routeTableData.Set("route_rules", []interface{}{
+		map[string]interface{}{"destination": "0.0.0.0/0", "destination_type": "CIDR_BLOCK", "network_entity_id": internetGateway.ocid},
+	})
+
+	routeTable := &OCIResource{
+		terraformClass: "oci_core_route_table",
+		terraformName:  "export_route_table",
+		ocid:           routeTableData.Id(),
+		hints:          exportCoreRouteTableHints,
+		data:           routeTableData,
+	}
+
+	hcl, err := ctx.getHCLString(routeTable)
+	assert.NoError(t, err)
+	assert.Contains(t, hcl, `    network_entity_id = "${oci_core_internet_gateway.export_internet_gateway.id}"`)
+	assert.Contains(t, hcl, `  vcn_id = "ocid1.vcn.oc1..test"`)
+	assert.Equal(t, []string{"oci_core_internet_gateway.export_internet_gateway"}, routeTable.dependencies)
+}
@@ -0,0 +1,333 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+// TerraformResourceHints describes how a resource type can be discovered and exported
+type TerraformResourceHints struct {
+	// The name of the resource type as it appears in resourcesMap()
+	resourceClass string
+	// The name of the plural data source from dataSourcesMap() that is used to list resources of this type
+	datasourceClass string
+	// The name of the list attribute in the data source that holds the discovered resources
+	datasourceItemsAttr string
+	// The name used for this resource type in DependencyGraph, if it cannot be derived from resourceClass
+	dependencyGraphName string
+}
+
+// TerraformResourceAssociation describes how a child resource type is discovered from its parent resource
+type TerraformResourceAssociation struct {
+	*TerraformResourceHints
+	// Maps a data source argument to the attribute of the parent resource that supplies its value
+	datasourceQueryParams map[string]string
+	// Parent attributes holding the OCID of a default resource that should not be exported (e.g. a VCN's default security list)
+	excludedParentAttributes []string
+}
+
+// TerraformResourceGraph maps a parent resource class to the resource types that can be discovered under it
+type TerraformResourceGraph map[string][]TerraformResourceAssociation
+
+const exportCompartmentRootClass = "oci_identity_compartment"
+
+var exportCoreVcnHints = &TerraformResourceHints{
+	resourceClass:       "oci_core_vcn",
+	datasourceClass:     "oci_core_vcns",
+	datasourceItemsAttr: "virtual_networks",
+}
+
+var exportCoreSubnetHints = &TerraformResourceHints{
+	resourceClass:       "oci_core_subnet",
+	datasourceClass:     "oci_core_subnets",
+	datasourceItemsAttr: "subnets",
+}
+
+var exportCoreSecurityListHints = &TerraformResourceHints{
+	resourceClass:       "oci_core_security_list",
+	datasourceClass:     "oci_core_security_lists",
+	datasourceItemsAttr: "security_lists",
+}
+
+var exportCoreRouteTableHints = &TerraformResourceHints{
+	resourceClass:       "oci_core_route_table",
+	datasourceClass:     "oci_core_route_tables",
+	datasourceItemsAttr: "route_tables",
+}
+
+var exportCoreDhcpOptionsHints = &TerraformResourceHints{
+	resourceClass:       "oci_core_dhcp_options",
+	datasourceClass:     "oci_core_dhcp_options",
+	datasourceItemsAttr: "options",
+}
+
+var exportCoreInternetGatewayHints = &TerraformResourceHints{
+	resourceClass:       "oci_core_internet_gateway",
+	datasourceClass:     "oci_core_internet_gateways",
+	datasourceItemsAttr: "gateways",
+}
+
+var exportCoreNatGatewayHints = &TerraformResourceHints{
+	resourceClass:       "oci_core_nat_gateway",
+	datasourceClass:     "oci_core_nat_gateways",
+	datasourceItemsAttr: "nat_gateways",
+}
+
+var exportCoreServiceGatewayHints = &TerraformResourceHints{
+	resourceClass:       "oci_core_service_gateway",
+	datasourceClass:     "oci_core_service_gateways",
+	datasourceItemsAttr: "service_gateways",
+}
+
+var exportCoreLocalPeeringGatewayHints = &TerraformResourceHints{
+	resourceClass:       "oci_core_local_peering_gateway",
+	datasourceClass:     "oci_core_local_peering_gateways",
+	datasourceItemsAttr: "local_peering_gateways",
+}
+
+var exportCoreNetworkSecurityGroupHints = &TerraformResourceHints{
+	resourceClass:       "oci_core_network_security_group",
+	datasourceClass:     "oci_core_network_security_groups",
+	datasourceItemsAttr: "network_security_groups",
+}
+
+var exportCoreDrgHints = &TerraformResourceHints{
+	resourceClass:       "oci_core_drg",
+	datasourceClass:     "oci_core_drgs",
+	datasourceItemsAttr: "drgs",
+}
+
+var exportCoreDrgAttachmentHints = &TerraformResourceHints{
+	resourceClass:       "oci_core_drg_attachment",
+	datasourceClass:     "oci_core_drg_attachments",
+	datasourceItemsAttr: "drg_attachments",
+}
+
+var exportCoreCpeHints = &TerraformResourceHints{
+	resourceClass:       "oci_core_cpe",
+	datasourceClass:     "oci_core_cpes",
+	datasourceItemsAttr: "cpes",
+}
+
+var exportCoreIpSecConnectionHints = &TerraformResourceHints{
+	resourceClass:       "oci_core_ipsec",
+	datasourceClass:     "oci_core_ipsec_connections",
+	datasourceItemsAttr: "connections",
+	dependencyGraphName: "CoreIpSecConnection",
+}
+
+var exportCoreInstanceHints = &TerraformResourceHints{
+	resourceClass:       "oci_core_instance",
+	datasourceClass:     "oci_core_instances",
+	datasourceItemsAttr: "instances",
+}
+
+var exportCoreVolumeHints = &TerraformResourceHints{
+	resourceClass:       "oci_core_volume",
+	datasourceClass:     "oci_core_volumes",
+	datasourceItemsAttr: "volumes",
+}
+
+var exportCoreVolumeGroupHints = &TerraformResourceHints{
+	resourceClass:       "oci_core_volume_group",
+	datasourceClass:     "oci_core_volume_groups",
+	datasourceItemsAttr: "volume_groups",
+}
+
+var exportCoreImageHints = &TerraformResourceHints{
+	resourceClass:       "oci_core_image",
+	datasourceClass:     "oci_core_images",
+	datasourceItemsAttr: "images",
+}
+
+var exportDatabaseDbSystemHints = &TerraformResourceHints{
+	resourceClass:       "oci_database_db_system",
+	datasourceClass:     "oci_database_db_systems",
+	datasourceItemsAttr: "db_systems",
+}
+
+var exportDatabaseAutonomousDatabaseHints = &TerraformResourceHints{
+	resourceClass:       "oci_database_autonomous_database",
+	datasourceClass:     "oci_database_autonomous_databases",
+	datasourceItemsAttr: "autonomous_databases",
+}
+
+var exportLoadBalancerLoadBalancerHints = &TerraformResourceHints{
+	resourceClass:       "oci_load_balancer_load_balancer",
+	datasourceClass:     "oci_load_balancer_load_balancers",
+	datasourceItemsAttr: "load_balancers",
+}
+
+var exportDnsZoneHints = &TerraformResourceHints{
+	resourceClass:       "oci_dns_zone",
+	datasourceClass:     "oci_dns_zones",
+	datasourceItemsAttr: "zones",
+}
+
+var exportFunctionsApplicationHints = &TerraformResourceHints{
+	resourceClass:       "oci_functions_application",
+	datasourceClass:     "oci_functions_applications",
+	datasourceItemsAttr: "applications",
+}
+
+var exportContainerengineClusterHints = &TerraformResourceHints{
+	resourceClass:       "oci_containerengine_cluster",
+	datasourceClass:     "oci_containerengine_clusters",
+	datasourceItemsAttr: "clusters",
+}
+
+var exportStreamingStreamHints = &TerraformResourceHints{
+	resourceClass:       "oci_streaming_stream",
+	datasourceClass:     "oci_streaming_streams",
+	datasourceItemsAttr: "streams",
+}
+
+var exportOnsNotificationTopicHints = &TerraformResourceHints{
+	resourceClass:       "oci_ons_notification_topic",
+	datasourceClass:     "oci_ons_notification_topics",
+	datasourceItemsAttr: "notification_topics",
+}
+
+var exportMonitoringAlarmHints = &TerraformResourceHints{
+	resourceClass:       "oci_monitoring_alarm",
+	datasourceClass:     "oci_monitoring_alarms",
+	datasourceItemsAttr: "alarms",
+}
+
+var exportEventsRuleHints = &TerraformResourceHints{
+	resourceClass:       "oci_events_rule",
+	datasourceClass:     "oci_events_rules",
+	datasourceItemsAttr: "rules",
+}
+
+var exportKmsVaultHints = &TerraformResourceHints{
+	resourceClass:       "oci_kms_vault",
+	datasourceClass:     "oci_kms_vaults",
+	datasourceItemsAttr: "vaults",
+}
+
+var exportIdentityPolicyHints = &TerraformResourceHints{
+	resourceClass:       "oci_identity_policy",
+	datasourceClass:     "oci_identity_policies",
+	datasourceItemsAttr: "policies",
+}
+
+// The resource graphs to walk when exporting a compartment, keyed by service name.
+// The discovered resources of each service are written to a file named after the service.
+var compartmentResourceGraphs = map[string]TerraformResourceGraph{
+	"core":            coreResourceGraph,
+	"database":        databaseResourceGraph,
+	"load_balancer":   loadBalancerResourceGraph,
+	"dns":             dnsResourceGraph,
+	"functions":       functionsResourceGraph,
+	"containerengine": containerengineResourceGraph,
+	"streaming":       streamingResourceGraph,
+	"ons":             onsResourceGraph,
+	"monitoring":      monitoringResourceGraph,
+	"events":          eventsResourceGraph,
+	"kms":             kmsResourceGraph,
+	"identity":        identityResourceGraph,
+}
+
+var vcnQueryParams = map[string]string{"vcn_id": "id"}
+
+var coreResourceGraph = TerraformResourceGraph{
+	exportCompartmentRootClass: {
+		{TerraformResourceHints: exportCoreVcnHints},
+		{TerraformResourceHints: exportCoreDrgHints},
+		{TerraformResourceHints: exportCoreCpeHints},
+		{TerraformResourceHints: exportCoreIpSecConnectionHints},
+		{TerraformResourceHints: exportCoreInstanceHints},
+		{TerraformResourceHints: exportCoreVolumeHints},
+		{TerraformResourceHints: exportCoreVolumeGroupHints},
+		{TerraformResourceHints: exportCoreImageHints},
+	},
+	"oci_core_vcn": {
+		{TerraformResourceHints: exportCoreSubnetHints, datasourceQueryParams: vcnQueryParams},
+		{
+			TerraformResourceHints:   exportCoreSecurityListHints,
+			datasourceQueryParams:    vcnQueryParams,
+			excludedParentAttributes: []string{"default_security_list_id"},
+		},
+		{
+			TerraformResourceHints:   exportCoreRouteTableHints,
+			datasourceQueryParams:    vcnQueryParams,
+			excludedParentAttributes: []string{"default_route_table_id"},
+		},
+		{
+			TerraformResourceHints:   exportCoreDhcpOptionsHints,
+			datasourceQueryParams:    vcnQueryParams,
+			excludedParentAttributes: []string{"default_dhcp_options_id"},
+		},
+		{TerraformResourceHints: exportCoreInternetGatewayHints, datasourceQueryParams: vcnQueryParams},
+		{TerraformResourceHints: exportCoreNatGatewayHints, datasourceQueryParams: vcnQueryParams},
+		{TerraformResourceHints: exportCoreServiceGatewayHints, datasourceQueryParams: vcnQueryParams},
+		{TerraformResourceHints: exportCoreLocalPeeringGatewayHints, datasourceQueryParams: vcnQueryParams},
+		{TerraformResourceHints: exportCoreNetworkSecurityGroupHints, datasourceQueryParams: vcnQueryParams},
+		{TerraformResourceHints: exportCoreDrgAttachmentHints, datasourceQueryParams: vcnQueryParams},
+	},
+}
+
+var databaseResourceGraph = TerraformResourceGraph{
+	exportCompartmentRootClass: {
+		{TerraformResourceHints: exportDatabaseDbSystemHints},
+		{TerraformResourceHints: exportDatabaseAutonomousDatabaseHints},
+	},
+}
+
+var loadBalancerResourceGraph = TerraformResourceGraph{
+	exportCompartmentRootClass: {
+		{TerraformResourceHints: exportLoadBalancerLoadBalancerHints},
+	},
+}
+
+var dnsResourceGraph = TerraformResourceGraph{
+	exportCompartmentRootClass: {
+		{TerraformResourceHints: exportDnsZoneHints},
+	},
+}
+
+var functionsResourceGraph = TerraformResourceGraph{
+	exportCompartmentRootClass: {
+		{TerraformResourceHints: exportFunctionsApplicationHints},
+	},
+}
+
+var containerengineResourceGraph = TerraformResourceGraph{
+	exportCompartmentRootClass: {
+		{TerraformResourceHints: exportContainerengineClusterHints},
+	},
+}
+
+var streamingResourceGraph = TerraformResourceGraph{
+	exportCompartmentRootClass: {
+		{TerraformResourceHints: exportStreamingStreamHints},
+	},
+}
+
+var onsResourceGraph = TerraformResourceGraph{
+	exportCompartmentRootClass: {
+		{TerraformResourceHints: exportOnsNotificationTopicHints},
+	},
+}
+
+var monitoringResourceGraph = TerraformResourceGraph{
+	exportCompartmentRootClass: {
+		{TerraformResourceHints: exportMonitoringAlarmHints},
+	},
+}
+
+var eventsResourceGraph = TerraformResourceGraph{
+	exportCompartmentRootClass: {
+		{TerraformResourceHints: exportEventsRuleHints},
+	},
+}
+
+var kmsResourceGraph = TerraformResourceGraph{
+	exportCompartmentRootClass: {
+		{TerraformResourceHints: exportKmsVaultHints},
+	},
+}
+
+var identityResourceGraph = TerraformResourceGraph{
+	exportCompartmentRootClass: {
+		{TerraformResourceHints: exportIdentityPolicyHints},
+	},
+}
@@ -0,0 +1,285 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	exportCompartmentVariableName = "compartment_ocid"
+	exportRegionVariableName      = "region"
+	exportResourceNamePrefix      = "export_"
+)
+
+var exportInvalidNameCharacters = regexp.MustCompile(`[^a-zA-Z0-9_-]`)
+
+var hclStringReplacer = strings.NewReplacer(
+	`\`, `\\`,
+	`"`, `\"`,
+	"\n", `\n`,
+	"\r", `\r`,
+	"\t", `\t`,
+	"${", "$${",
+	"%{", "%%{",
+)
+
+func (ctx *resourceDiscoveryContext) getVariablesHCLString() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "provider \"oci\" {\n  region = \"${var.%s}\"\n}\n\n", exportRegionVariableName)
+	fmt.Fprintf(&b, "variable %q {\n  default = %q\n}\n\n", exportRegionVariableName, ctx.region)
+	fmt.Fprintf(&b, "variable %q {\n  default = %q\n}\n", exportCompartmentVariableName, ctx.compartmentId)
+	return b.String()
+}
+
+// getHCLString writes the configurable attributes of a discovered resource as a Terraform resource block.
+// Attributes holding the OCID of another exported resource are replaced with references to that resource.
+func (ctx *resourceDiscoveryContext) getHCLString(resource *OCIResource) (string, error) {
+	resourceSchema, ok := resourcesMap()[resource.terraformClass]
+	if !ok {
+		return "", fmt.Errorf("resource '%s' does not exist", resource.terraformClass)
+	}
+
+	values := map[string]interface{}{}
+	for attributeName, attributeSchema := range resourceSchema.Schema {
+		if !isExportableAttribute(attributeSchema) {
+			continue
+		}
+		if attributeSchema.Required {
+			values[attributeName] = resource.data.Get(attributeName)
+		} else if value, ok := resource.data.GetOk(attributeName); ok {
+			values[attributeName] = value
+		}
+	}
+
+	referenceAttributes := getReferenceAttributes(resource.hints)
+	dependencies := map[string]bool{}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "resource %q %q {\n", resource.terraformClass, resource.terraformName)
+	if err := ctx.writeHCLBlock(&b, resourceSchema.Schema, values, referenceAttributes, dependencies, 1); err != nil {
+		return "", err
+	}
+	b.WriteString("}\n")
+
+	resource.dependencies = []string{}
+	for dependency := range dependencies {
+		resource.dependencies = append(resource.dependencies, dependency)
+	}
+	sort.Strings(resource.dependencies)
+
+	return b.String(), nil
+}
+
+func (ctx *resourceDiscoveryContext) writeHCLBlock(b *strings.Builder, schemaMap map[string]*schema.Schema, values map[string]interface{}, referenceAttributes map[string]bool, dependencies map[string]bool, indentLevel int) error {
+	indent := strings.Repeat("  ", indentLevel)
+
+	attributeNames := []string{}
+	for attributeName := range values {
+		attributeNames = append(attributeNames, attributeName)
+	}
+	sort.Strings(attributeNames)
+
+	for _, attributeName := range attributeNames {
+		attributeSchema, ok := schemaMap[attributeName]
+		if !ok {
+			continue
+		}
+		value := values[attributeName]
+
+		switch attributeSchema.Type {
+		case schema.TypeList, schema.TypeSet:
+			items := interfaceToList(value)
+			if elemResource, ok := attributeSchema.Elem.(*schema.Resource); ok {
+				for _, item := range items {
+					itemMap, ok := item.(map[string]interface{})
+					if !ok {
+						return fmt.Errorf("unexpected value %v for block '%s'", item, attributeName)
+					}
+					nestedValues := map[string]interface{}{}
+					for nestedName, nestedSchema := range elemResource.Schema {
+						nestedValue, exists := itemMap[nestedName]
+						if !exists || !isExportableAttribute(nestedSchema) {
+							continue
+						}
+						if nestedSchema.Required || !isZeroExportValue(nestedValue) {
+							nestedValues[nestedName] = nestedValue
+						}
+					}
+					fmt.Fprintf(b, "%s%s {\n", indent, attributeName)
+					if err := ctx.writeHCLBlock(b, elemResource.Schema, nestedValues, referenceAttributes, dependencies, indentLevel+1); err != nil {
+						return err
+					}
+					fmt.Fprintf(b, "%s}\n", indent)
+				}
+			} else {
+				formattedItems := []string{}
+				for _, item := range items {
+					formattedItems = append(formattedItems, ctx.getHCLValue(attributeName, item, referenceAttributes, dependencies))
+				}
+				fmt.Fprintf(b, "%s%s = [%s]\n", indent, attributeName, strings.Join(formattedItems, ", "))
+			}
+		case schema.TypeMap:
+			valueMap, _ := value.(map[string]interface{})
+			keys := []string{}
+			for key := range valueMap {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+
+			fmt.Fprintf(b, "%s%s = {\n", indent, attributeName)
+			for _, key := range keys {
+				fmt.Fprintf(b, "%s  \"%s\" = %s\n", indent, hclStringReplacer.Replace(key), ctx.getHCLValue(key, valueMap[key], nil, dependencies))
+			}
+			fmt.Fprintf(b, "%s}\n", indent)
+		default:
+			fmt.Fprintf(b, "%s%s = %s\n", indent, attributeName, ctx.getHCLValue(attributeName, value, referenceAttributes, dependencies))
+		}
+	}
+
+	return nil
+}
+
+func (ctx *resourceDiscoveryContext) getHCLValue(attributeName string, value interface{}, referenceAttributes map[string]bool, dependencies map[string]bool) string {
+	switch v := value.(type) {
+	case string:
+		if attributeName == "compartment_id" && v == ctx.compartmentId {
+			return fmt.Sprintf("\"${var.%s}\"", exportCompartmentVariableName)
+		}
+		if isReferenceAttribute(attributeName, referenceAttributes) {
+			if referencedResource, ok := ctx.resourcesByOcid[v]; ok {
+				dependencies[referencedResource.getTerraformReference()] = true
+				return fmt.Sprintf("\"${%s.id}\"", referencedResource.getTerraformReference())
+			}
+		}
+		return fmt.Sprintf("\"%s\"", hclStringReplacer.Replace(v))
+	case bool, int, int64, float64:
+		return fmt.Sprintf("%v", v)
+	default:
+		return fmt.Sprintf("\"%s\"", hclStringReplacer.Replace(fmt.Sprintf("%v", v)))
+	}
+}
+
+// isReferenceAttribute returns whether an attribute, or an element of a list attribute, may hold the OCID of another resource.
+// Besides the attributes of the DependencyGraph, attributes such as "security_list_ids" or "network_entity_id" of a nested block
+// are references when they hold the OCID of an exported resource.
+func isReferenceAttribute(attributeName string, referenceAttributes map[string]bool) bool {
+	if referenceAttributes[attributeName] {
+		return true
+	}
+	return attributeName != "compartment_id" && (strings.HasSuffix(attributeName, "_id") || strings.HasSuffix(attributeName, "_ids"))
+}
+
+// getReferenceAttributes uses the DependencyGraph to find the attributes of a resource type that may refer to another resource
+// e.g. DependencyGraph["vcn"] contains "CoreSubnet", so the "vcn_id" attribute of "oci_core_subnet" is a reference
+func getReferenceAttributes(hints *TerraformResourceHints) map[string]bool {
+	result := map[string]bool{}
+	if hints == nil {
+		return result
+	}
+
+	graphName := hints.dependencyGraphName
+	if graphName == "" {
+		graphName = strings.TrimPrefix(hints.resourceClass, "oci_")
+	}
+	graphName = normalizeDependencyGraphName(graphName)
+
+	for referencedResource, dependentResources := range DependencyGraph {
+		for _, dependentResource := range dependentResources {
+			if normalizeDependencyGraphName(dependentResource) == graphName {
+				result[camelCaseToSnakeCase(referencedResource)+"_id"] = true
+				result[camelCaseToSnakeCase(referencedResource)+"_ids"] = true
+			}
+		}
+	}
+	return result
+}
+
+func normalizeDependencyGraphName(name string) string {
+	return strings.ToLower(strings.Replace(name, "_", "", -1))
+}
+
+func camelCaseToSnakeCase(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteRune('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// generateTerraformName returns a unique, valid Terraform resource name based on the display name of the resource
+func (ctx *resourceDiscoveryContext) generateTerraformName(terraformClass string, displayName string) string {
+	name := exportResourceNamePrefix + exportInvalidNameCharacters.ReplaceAllString(displayName, "_")
+	if displayName == "" {
+		name = exportResourceNamePrefix + strings.TrimPrefix(terraformClass, "oci_")
+	}
+
+	uniqueName := name
+	for i := 1; ctx.terraformNames[terraformClass+"."+uniqueName]; i++ {
+		uniqueName = fmt.Sprintf("%s_%d", name, i)
+	}
+	ctx.terraformNames[terraformClass+"."+uniqueName] = true
+
+	return uniqueName
+}
+
+func getExportDisplayName(item map[string]interface{}) string {
+	for _, attributeName := range []string{"display_name", "name"} {
+		if displayName, ok := item[attributeName].(string); ok && displayName != "" {
+			return displayName
+		}
+	}
+	return ""
+}
+
+// Computed-only and deprecated attributes are not written to the generated configuration
+func isExportableAttribute(attributeSchema *schema.Schema) bool {
+	if attributeSchema.Computed && !attributeSchema.Optional && !attributeSchema.Required {
+		return false
+	}
+	return attributeSchema.Deprecated == "" && attributeSchema.Removed == ""
+}
+
+func isZeroExportValue(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case int:
+		return v == 0
+	case float64:
+		return v == 0
+	case bool:
+		return !v
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	case *schema.Set:
+		return v.Len() == 0
+	}
+	return false
+}
+
+func interfaceToList(value interface{}) []interface{} {
+	switch v := value.(type) {
+	case []interface{}:
+		return v
+	case *schema.Set:
+		return v.List()
+	}
+	return []interface{}{}
+}
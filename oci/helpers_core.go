@@ -4,6 +4,7 @@ package provider
 
 import (
 	"context"
+	"log"
 	"net"
 	"strings"
 	"time"
@@ -159,3 +160,149 @@ func ipv6CompressionDiffSuppressFunction(key string, old string, new string, d *
 	newSubnetMask := oldIp[1]
 	return strings.EqualFold(oldParsedIp.String(), newParsedIp.String()) && strings.EqualFold(oldSubnetMask, newSubnetMask)
 }
+
+// getInstancePoolLoadBalancerChanges compares the old and new load_balancers of an instance pool. An attachment whose
+// port or vnic_selection changed is detached and attached again, since attachments cannot be updated in place
+func getInstancePoolLoadBalancerChanges(oldLoadBalancers []interface{}, newLoadBalancers []interface{}) (toDetach []oci_core.AttachLoadBalancerDetails, toAttach []oci_core.AttachLoadBalancerDetails) {
+	oldDetails := attachLoadBalancerDetailsFromMaps(oldLoadBalancers)
+	newDetails := attachLoadBalancerDetailsFromMaps(newLoadBalancers)
+
+	contains := func(list []oci_core.AttachLoadBalancerDetails, details oci_core.AttachLoadBalancerDetails) bool {
+		for _, item := range list {
+			if *item.LoadBalancerId == *details.LoadBalancerId && *item.BackendSetName == *details.BackendSetName &&
+				*item.Port == *details.Port && *item.VnicSelection == *details.VnicSelection {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, details := range oldDetails {
+		if !contains(newDetails, details) {
+			toDetach = append(toDetach, details)
+		}
+	}
+
+	for _, details := range newDetails {
+		if !contains(oldDetails, details) {
+			toAttach = append(toAttach, details)
+		}
+	}
+
+	return
+}
+
+func attachLoadBalancerDetailsFromMaps(loadBalancers []interface{}) []oci_core.AttachLoadBalancerDetails {
+	result := []oci_core.AttachLoadBalancerDetails{}
+	for _, item := range loadBalancers {
+		loadBalancer, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		loadBalancerId, _ := loadBalancer["load_balancer_id"].(string)
+		backendSetName, _ := loadBalancer["backend_set_name"].(string)
+		port, _ := loadBalancer["port"].(int)
+		vnicSelection, _ := loadBalancer["vnic_selection"].(string)
+
+		result = append(result, oci_core.AttachLoadBalancerDetails{
+			LoadBalancerId: &loadBalancerId,
+			BackendSetName: &backendSetName,
+			Port:           &port,
+			VnicSelection:  &vnicSelection,
+		})
+	}
+	return result
+}
+
+// findInstancePoolLoadBalancerAttachment returns the attachment of the backend set to the pool, preferring an active
+// attachment over a detached one left from an earlier attachment of the same backend set
+func findInstancePoolLoadBalancerAttachment(instancePool *oci_core.InstancePool, loadBalancerId *string, backendSetName *string) *oci_core.InstancePoolLoadBalancerAttachment {
+	if instancePool == nil || loadBalancerId == nil || backendSetName == nil {
+		return nil
+	}
+
+	var result *oci_core.InstancePoolLoadBalancerAttachment
+	for i, attachment := range instancePool.LoadBalancers {
+		if attachment.LoadBalancerId == nil || attachment.BackendSetName == nil ||
+			*attachment.LoadBalancerId != *loadBalancerId || *attachment.BackendSetName != *backendSetName {
+			continue
+		}
+
+		if attachment.LifecycleState != oci_core.InstancePoolLoadBalancerAttachmentLifecycleStateDetached {
+			return &instancePool.LoadBalancers[i]
+		}
+		result = &instancePool.LoadBalancers[i]
+	}
+	return result
+}
+
+// findActiveBootVolumeAttachment returns the attachment of the boot volume the instance currently boots from. Detached
+// attachments are skipped, since they remain listed after a boot volume has been detached from the instance.
+func findActiveBootVolumeAttachment(attachments []oci_core.BootVolumeAttachment) *oci_core.BootVolumeAttachment {
+	for i, attachment := range attachments {
+		if attachment.LifecycleState == oci_core.BootVolumeAttachmentLifecycleStateAttaching ||
+			attachment.LifecycleState == oci_core.BootVolumeAttachmentLifecycleStateAttached {
+			return &attachments[i]
+		}
+	}
+	return nil
+}
+
+// volumeKmsKeyCustomizeDiff plans the changes of the KMS key of a volume or boot volume that are not driven by its config.
+// kms_key_id is also computed for volumes created from an encrypted source, so the key is only removed when
+// remove_kms_key is set. kms_key_version_id keeps the key version the volume was last assigned, when the key has been
+// rotated since, the current version is planned so that the key is assigned again. The key is looked up on every plan,
+// failing to read it only skips the rotation check so that the plan does not depend on access to the vault.
+func volumeKmsKeyCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	if removeKmsKey, ok := d.GetOk("remove_kms_key"); ok && removeKmsKey.(bool) {
+		if d.Get("kms_key_id").(string) == "" {
+			return nil
+		}
+		if err := d.SetNew("kms_key_id", ""); err != nil {
+			return err
+		}
+		return d.SetNew("kms_key_version_id", "")
+	}
+
+	if d.HasChange("kms_key_id") {
+		return d.SetNewComputed("kms_key_version_id")
+	}
+
+	managementEndpoint := d.Get("kms_key_management_endpoint").(string)
+	kmsKeyId := d.Get("kms_key_id").(string)
+	clients, ok := m.(*OracleClients)
+	if managementEndpoint == "" || kmsKeyId == "" || !ok {
+		return nil
+	}
+
+	currentKeyVersionId, err := getKmsKeyCurrentVersionId(clients, managementEndpoint, kmsKeyId)
+	if err != nil {
+		log.Printf("[WARN] unable to check whether the KMS key %s of %s has been rotated: %v", kmsKeyId, d.Id(), err)
+		return nil
+	}
+	if currentKeyVersionId != d.Get("kms_key_version_id").(string) {
+		return d.SetNew("kms_key_version_id", currentKeyVersionId)
+	}
+	return nil
+}
+
+// setVolumeKmsKeyVersionId records the current version of the key assigned to a volume or boot volume, it is only known
+// when the management endpoint of the key's vault is set
+func setVolumeKmsKeyVersionId(d *schema.ResourceData, clients *OracleClients) error {
+	managementEndpoint := d.Get("kms_key_management_endpoint").(string)
+	kmsKeyId := d.Get("kms_key_id").(string)
+	if managementEndpoint == "" || kmsKeyId == "" {
+		return d.Set("kms_key_version_id", "")
+	}
+
+	currentKeyVersionId, err := getKmsKeyCurrentVersionId(clients, managementEndpoint, kmsKeyId)
+	if err != nil {
+		return err
+	}
+	return d.Set("kms_key_version_id", currentKeyVersionId)
+}
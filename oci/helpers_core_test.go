@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"testing"
 	"time"
 
 	oci_common "github.com/oracle/oci-go-sdk/common"
@@ -177,3 +178,69 @@ func volumeBackupAvailableWaitCondition(response oci_common.OCIOperationResponse
 
 	return false
 }
+
+func TestUnitGetInstancePoolLoadBalancerChanges(t *testing.T) {
+	loadBalancer := func(loadBalancerId string, backendSetName string, port int) map[string]interface{} {
+		return map[string]interface{}{
+			"load_balancer_id": loadBalancerId,
+			"backend_set_name": backendSetName,
+			"port":             port,
+			"vnic_selection":   "PrimaryVnic",
+		}
+	}
+
+	oldLoadBalancers := []interface{}{loadBalancer("lb1", "bs1", 80), loadBalancer("lb1", "bs2", 80)}
+	newLoadBalancers := []interface{}{loadBalancer("lb1", "bs1", 80), loadBalancer("lb1", "bs2", 8080), loadBalancer("lb2", "bs1", 80)}
+
+	toDetach, toAttach := getInstancePoolLoadBalancerChanges(oldLoadBalancers, newLoadBalancers)
+	if len(toDetach) != 1 || *toDetach[0].BackendSetName != "bs2" || *toDetach[0].Port != 80 {
+		t.Errorf("expected only the backend set bs2 on port 80 to be detached, got %v", toDetach)
+	}
+	if len(toAttach) != 2 || *toAttach[0].Port != 8080 || *toAttach[1].LoadBalancerId != "lb2" {
+		t.Errorf("expected the backend set bs2 on port 8080 and the load balancer lb2 to be attached, got %v", toAttach)
+	}
+
+	toDetach, toAttach = getInstancePoolLoadBalancerChanges(oldLoadBalancers, []interface{}{})
+	if len(toDetach) != 2 || len(toAttach) != 0 {
+		t.Errorf("expected all load balancers to be detached, got %d detached and %d attached", len(toDetach), len(toAttach))
+	}
+}
+
+func TestUnitFindInstancePoolLoadBalancerAttachment(t *testing.T) {
+	loadBalancerId := "lb1"
+	backendSetName := "bs1"
+	instancePool := &oci_core.InstancePool{
+		LoadBalancers: []oci_core.InstancePoolLoadBalancerAttachment{
+			{LoadBalancerId: &loadBalancerId, BackendSetName: &backendSetName, LifecycleState: oci_core.InstancePoolLoadBalancerAttachmentLifecycleStateDetached},
+			{LoadBalancerId: &loadBalancerId, BackendSetName: &backendSetName, LifecycleState: oci_core.InstancePoolLoadBalancerAttachmentLifecycleStateAttaching},
+		},
+	}
+
+	attachment := findInstancePoolLoadBalancerAttachment(instancePool, &loadBalancerId, &backendSetName)
+	if attachment == nil || attachment.LifecycleState != oci_core.InstancePoolLoadBalancerAttachmentLifecycleStateAttaching {
+		t.Errorf("expected the attaching attachment to be preferred over the detached one, got %v", attachment)
+	}
+
+	otherBackendSetName := "bs2"
+	if attachment := findInstancePoolLoadBalancerAttachment(instancePool, &loadBalancerId, &otherBackendSetName); attachment != nil {
+		t.Errorf("expected no attachment for another backend set, got %v", attachment)
+	}
+}
+
+func TestUnitFindActiveBootVolumeAttachment(t *testing.T) {
+	originalBootVolumeId := "bv1"
+	repairedBootVolumeId := "bv2"
+	attachments := []oci_core.BootVolumeAttachment{
+		{BootVolumeId: &originalBootVolumeId, LifecycleState: oci_core.BootVolumeAttachmentLifecycleStateDetached},
+		{BootVolumeId: &repairedBootVolumeId, LifecycleState: oci_core.BootVolumeAttachmentLifecycleStateAttached},
+	}
+
+	attachment := findActiveBootVolumeAttachment(attachments)
+	if attachment == nil || *attachment.BootVolumeId != repairedBootVolumeId {
+		t.Errorf("expected the attached boot volume to be found, got %v", attachment)
+	}
+
+	if attachment := findActiveBootVolumeAttachment(attachments[:1]); attachment != nil {
+		t.Errorf("expected no attachment when the boot volume is detached, got %v", attachment)
+	}
+}
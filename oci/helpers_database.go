@@ -0,0 +1,143 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	oci_database "github.com/oracle/oci-go-sdk/database"
+)
+
+// The arguments used by oci_database_db_system and oci_database_db_home to precheck or apply a patch. Changing either of
+// them performs the action once, the service does not report them back.
+func databasePatchVersionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+}
+
+func databasePatchActionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		DiffSuppressFunc: EqualIgnoreCaseSuppressDiff,
+		ValidateFunc: validation.StringInSlice([]string{
+			string(oci_database.PatchDetailsActionApply),
+			string(oci_database.PatchDetailsActionPrecheck),
+		}, true),
+	}
+}
+
+// validateDatabasePatchOnCreate fails the plan of a new DB system or DB home that sets a patch, the patches available to it
+// are only known once it exists
+func validateDatabasePatchOnCreate(d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() != "" {
+		return nil
+	}
+	for _, key := range []string{"patch_version", "patch_action"} {
+		if value, ok := d.GetOk(key); ok {
+			return fmt.Errorf("%s %s can't be set when the resource is created, set it once the resource exists to patch it", key, value)
+		}
+	}
+	return nil
+}
+
+// restoreDatabasePatchDetails keeps the previous patch_version and patch_action in the state when the patch action fails,
+// so that the action is performed again by the next apply
+func restoreDatabasePatchDetails(d *schema.ResourceData) {
+	for _, key := range []string{"patch_version", "patch_action"} {
+		oldValue, _ := d.GetChange(key)
+		d.Set(key, oldValue)
+	}
+}
+
+// getDatabasePatchDetails returns the patch version and action to perform, ok is false when there is nothing to do
+func getDatabasePatchDetails(d *schema.ResourceData) (version string, action oci_database.PatchDetailsActionEnum, ok bool, err error) {
+	if !d.HasChange("patch_version") && !d.HasChange("patch_action") {
+		return
+	}
+
+	version, _ = d.Get("patch_version").(string)
+	actionStr, _ := d.Get("patch_action").(string)
+	if version == "" {
+		if actionStr != "" {
+			err = fmt.Errorf("patch_action %s requires a patch_version", actionStr)
+		}
+		return
+	}
+	if actionStr == "" {
+		err = fmt.Errorf("patch_version %s requires a patch_action, either %s or %s", version, oci_database.PatchDetailsActionPrecheck, oci_database.PatchDetailsActionApply)
+		return
+	}
+
+	return version, oci_database.PatchDetailsActionEnum(strings.ToUpper(actionStr)), true, nil
+}
+
+// findDatabasePatchId pages through the patches available to a DB system or DB home and returns the ID of the one with the given version
+func findDatabasePatchId(version string, listPatches func(page *string) ([]oci_database.PatchSummary, *string, error)) (string, error) {
+	available := []string{}
+	var page *string
+	for {
+		items, nextPage, err := listPatches(page)
+		if err != nil {
+			return "", err
+		}
+
+		for _, item := range items {
+			if item.Version == nil || item.Id == nil {
+				continue
+			}
+			if *item.Version == version {
+				return *item.Id, nil
+			}
+			available = append(available, *item.Version)
+		}
+
+		if nextPage == nil {
+			break
+		}
+		page = nextPage
+	}
+
+	return "", fmt.Errorf("patch version %s is not available, available versions are: [%s]", version, strings.Join(available, ", "))
+}
+
+func validateDatabasePatchAction(patch oci_database.Patch, action oci_database.PatchDetailsActionEnum) error {
+	for _, availableAction := range patch.AvailableActions {
+		if string(availableAction) == string(action) {
+			return nil
+		}
+	}
+
+	version := ""
+	if patch.Version != nil {
+		version = *patch.Version
+	}
+	return fmt.Errorf("action %s is not available for patch version %s, available actions are: %v", action, version, patch.AvailableActions)
+}
+
+func databasePatchHistoryEntryInProgress(entry oci_database.PatchHistoryEntry) bool {
+	return entry.LifecycleState == oci_database.PatchHistoryEntryLifecycleStateInProgress
+}
+
+// databasePatchHistoryEntryError surfaces the lifecycle details of a patch action that did not succeed
+func databasePatchHistoryEntryError(entry oci_database.PatchHistoryEntry) error {
+	if entry.LifecycleState == oci_database.PatchHistoryEntryLifecycleStateSucceeded {
+		return nil
+	}
+
+	details := ""
+	if entry.LifecycleDetails != nil {
+		details = *entry.LifecycleDetails
+	}
+	id := ""
+	if entry.Id != nil {
+		id = *entry.Id
+	}
+	return fmt.Errorf("patch action %s finished in state %s, patch history entry %s: %s", entry.Action, entry.LifecycleState, id, details)
+}
@@ -0,0 +1,124 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	oci_common "github.com/oracle/oci-go-sdk/common"
+	oci_database "github.com/oracle/oci-go-sdk/database"
+)
+
+func TestUnitFindDatabasePatchId(t *testing.T) {
+	pages := map[string][]oci_database.PatchSummary{
+		"": {
+			{Id: oci_common.String("ocid1.dbpatch.1"), Version: oci_common.String("12.2.0.1.180417")},
+		},
+		"page2": {
+			{Id: oci_common.String("ocid1.dbpatch.2"), Version: oci_common.String("12.2.0.1.180717")},
+		},
+	}
+	listPatches := func(page *string) ([]oci_database.PatchSummary, *string, error) {
+		if page == nil {
+			return pages[""], oci_common.String("page2"), nil
+		}
+		return pages[*page], nil, nil
+	}
+
+	patchId, err := findDatabasePatchId("12.2.0.1.180717", listPatches)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if patchId != "ocid1.dbpatch.2" {
+		t.Errorf("expected ocid1.dbpatch.2, got %s", patchId)
+	}
+
+	_, err = findDatabasePatchId("18.1.0.0.0", listPatches)
+	if err == nil || !strings.Contains(err.Error(), "12.2.0.1.180417, 12.2.0.1.180717") {
+		t.Errorf("expected an error listing the available versions, got %v", err)
+	}
+
+	_, err = findDatabasePatchId("18.1.0.0.0", func(page *string) ([]oci_database.PatchSummary, *string, error) {
+		return nil, nil, fmt.Errorf("service error")
+	})
+	if err == nil || err.Error() != "service error" {
+		t.Errorf("expected the service error to be returned, got %v", err)
+	}
+}
+
+func TestUnitValidateDatabasePatchAction(t *testing.T) {
+	patch := oci_database.Patch{
+		Version:          oci_common.String("12.2.0.1.180717"),
+		AvailableActions: []oci_database.PatchAvailableActionsEnum{oci_database.PatchAvailableActionsPrecheck},
+	}
+
+	if err := validateDatabasePatchAction(patch, oci_database.PatchDetailsActionPrecheck); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := validateDatabasePatchAction(patch, oci_database.PatchDetailsActionApply); err == nil {
+		t.Errorf("expected an error for an action that is not available")
+	}
+}
+
+func TestUnitDatabasePatchHistoryEntryError(t *testing.T) {
+	entry := oci_database.PatchHistoryEntry{
+		Id:             oci_common.String("ocid1.dbpatchhistoryentry.1"),
+		Action:         oci_database.PatchHistoryEntryActionApply,
+		LifecycleState: oci_database.PatchHistoryEntryLifecycleStateSucceeded,
+	}
+	if err := databasePatchHistoryEntryError(entry); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	entry.LifecycleState = oci_database.PatchHistoryEntryLifecycleStateFailed
+	entry.LifecycleDetails = oci_common.String("precheck failed: insufficient space")
+	err := databasePatchHistoryEntryError(entry)
+	if err == nil || !strings.Contains(err.Error(), "insufficient space") {
+		t.Errorf("expected an error with the lifecycle details, got %v", err)
+	}
+}
+
+func TestUnitDatabasePatchDetails_createAndFailedAction(t *testing.T) {
+	patchResource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"patch_version": databasePatchVersionSchema(),
+			"patch_action":  databasePatchActionSchema(),
+		},
+		CustomizeDiff: validateDatabasePatchOnCreate,
+		Update: func(d *schema.ResourceData, m interface{}) error {
+			d.Partial(true)
+			restoreDatabasePatchDetails(d)
+			return fmt.Errorf("precheck failed")
+		},
+	}
+	patchConfig := func(version string) *terraform.ResourceConfig {
+		raw, err := config.NewRawConfig(map[string]interface{}{"patch_version": version, "patch_action": "PRECHECK"})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return terraform.NewResourceConfig(raw)
+	}
+
+	if _, err := patchResource.Diff(nil, patchConfig("18.5.0.0"), nil); err == nil {
+		t.Errorf("Expected an error when a patch is set on create")
+	}
+
+	existing := &terraform.InstanceState{ID: "ocid1.dbhome.oc1..aaaa", Attributes: map[string]string{"patch_version": "18.4.0.0", "patch_action": "APPLY"}}
+	diff, err := patchResource.Diff(existing, patchConfig("18.5.0.0"), nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	state, err := patchResource.Apply(existing, diff, nil)
+	if err == nil {
+		t.Fatalf("Expected the error of the patch action")
+	}
+	if state.Attributes["patch_version"] != "18.4.0.0" || state.Attributes["patch_action"] != "APPLY" {
+		t.Errorf("Expected the previous patch to be kept in the state after a failed action, got %v", state.Attributes)
+	}
+}
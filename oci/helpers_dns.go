@@ -0,0 +1,200 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+
+	oci_dns "github.com/oracle/oci-go-sdk/dns"
+)
+
+// The records owned by oci_dns_rrset and oci_dns_zone_records. Records are identified by their domain, rtype, rdata and ttl,
+// so changing any of them replaces the record within the set rather than the whole resource.
+func dnsRecordItemsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Set:      dnsRecordItemHashCodeForSets,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				// Required
+				"domain": {
+					Type:     schema.TypeString,
+					Required: true,
+					DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+						return normalizeDnsDomain(old) == normalizeDnsDomain(new)
+					},
+				},
+				"rdata": {
+					Type:     schema.TypeString,
+					Required: true,
+					DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+						rtype, _ := d.Get(strings.TrimSuffix(k, "rdata") + "rtype").(string)
+						return normalizeRData(rtype, old) == normalizeRData(rtype, new)
+					},
+				},
+				"rtype": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"ttl": {
+					Type:     schema.TypeInt,
+					Required: true,
+				},
+
+				// Computed
+				"is_protected": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"record_hash": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"rrset_version": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func dnsRecordItemHashCodeForSets(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+
+	rtype, _ := m["rtype"].(string)
+	rtype = strings.ToUpper(rtype)
+	buf.WriteString(fmt.Sprintf("%v-", rtype))
+
+	if domain, ok := m["domain"]; ok {
+		buf.WriteString(fmt.Sprintf("%v-", normalizeDnsDomain(domain.(string))))
+	}
+
+	if rdata, ok := m["rdata"]; ok {
+		buf.WriteString(fmt.Sprintf("%v-", normalizeRData(rtype, rdata.(string))))
+	}
+
+	if ttl, ok := m["ttl"]; ok {
+		buf.WriteString(fmt.Sprintf("%v-", ttl))
+	}
+
+	return hashcode.String(buf.String())
+}
+
+// Domains are case insensitive and may be written as fully qualified names
+func normalizeDnsDomain(domain string) string {
+	return strings.ToLower(strings.TrimSuffix(domain, "."))
+}
+
+func dnsRecordToMap(obj oci_dns.Record) map[string]interface{} {
+	result := map[string]interface{}{}
+
+	if obj.Domain != nil {
+		result["domain"] = string(*obj.Domain)
+	}
+
+	if obj.IsProtected != nil {
+		result["is_protected"] = bool(*obj.IsProtected)
+	}
+
+	if obj.Rdata != nil {
+		result["rdata"] = string(*obj.Rdata)
+	}
+
+	if obj.RecordHash != nil {
+		result["record_hash"] = string(*obj.RecordHash)
+	}
+
+	if obj.RrsetVersion != nil {
+		result["rrset_version"] = string(*obj.RrsetVersion)
+	}
+
+	if obj.Rtype != nil {
+		result["rtype"] = string(*obj.Rtype)
+	}
+
+	if obj.Ttl != nil {
+		result["ttl"] = int(*obj.Ttl)
+	}
+
+	return result
+}
+
+func mapToDnsRecordDetails(m map[string]interface{}) oci_dns.RecordDetails {
+	result := oci_dns.RecordDetails{}
+
+	if domain, ok := m["domain"].(string); ok {
+		result.Domain = &domain
+	}
+
+	if rdata, ok := m["rdata"].(string); ok {
+		result.Rdata = &rdata
+	}
+
+	if rtype, ok := m["rtype"].(string); ok {
+		result.Rtype = &rtype
+	}
+
+	if ttl, ok := m["ttl"].(int); ok {
+		result.Ttl = &ttl
+	}
+
+	return result
+}
+
+func dnsRecordsToSet(records []oci_dns.Record) *schema.Set {
+	items := []interface{}{}
+	for _, item := range records {
+		items = append(items, dnsRecordToMap(item))
+	}
+	return schema.NewSet(dnsRecordItemHashCodeForSets, items)
+}
+
+func dnsRecordDetailsFromSet(items *schema.Set) []oci_dns.RecordDetails {
+	result := []oci_dns.RecordDetails{}
+	for _, item := range items.List() {
+		result = append(result, mapToDnsRecordDetails(item.(map[string]interface{})))
+	}
+	return result
+}
+
+// getDnsRecordOperations returns the minimal patch that turns the old records into the new ones. Records that are
+// unchanged are left out of the patch; removed records are matched by their record hash when it is known.
+func getDnsRecordOperations(oldItems *schema.Set, newItems *schema.Set) []oci_dns.RecordOperation {
+	result := []oci_dns.RecordOperation{}
+
+	for _, item := range oldItems.Difference(newItems).List() {
+		m := item.(map[string]interface{})
+		operation := oci_dns.RecordOperation{Operation: oci_dns.RecordOperationOperationRemove}
+		if recordHash, ok := m["record_hash"].(string); ok && recordHash != "" {
+			operation.RecordHash = &recordHash
+		} else {
+			details := mapToDnsRecordDetails(m)
+			operation.Domain = details.Domain
+			operation.Rdata = details.Rdata
+			operation.Rtype = details.Rtype
+			operation.Ttl = details.Ttl
+		}
+		result = append(result, operation)
+	}
+
+	for _, item := range newItems.Difference(oldItems).List() {
+		details := mapToDnsRecordDetails(item.(map[string]interface{}))
+		result = append(result, oci_dns.RecordOperation{
+			Operation: oci_dns.RecordOperationOperationAdd,
+			Domain:    details.Domain,
+			Rdata:     details.Rdata,
+			Rtype:     details.Rtype,
+			Ttl:       details.Ttl,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	oci_dns "github.com/oracle/oci-go-sdk/dns"
+)
+
+func TestUnitDnsRecordItemHashCodeForSets(t *testing.T) {
+	configured := map[string]interface{}{"domain": "Mail.Example.com", "rtype": "cname", "rdata": "www.example.com", "ttl": 3600}
+	returned := map[string]interface{}{"domain": "mail.example.com", "rtype": "CNAME", "rdata": "www.example.com.", "ttl": 3600, "record_hash": "abc"}
+	if dnsRecordItemHashCodeForSets(configured) != dnsRecordItemHashCodeForSets(returned) {
+		t.Errorf("expected the configured and returned records to hash to the same value")
+	}
+
+	returned["ttl"] = 1000
+	if dnsRecordItemHashCodeForSets(configured) == dnsRecordItemHashCodeForSets(returned) {
+		t.Errorf("expected records with different ttls to hash to different values")
+	}
+}
+
+func TestUnitGetDnsRecordOperations(t *testing.T) {
+	unchanged := map[string]interface{}{"domain": "www.example.com", "rtype": "A", "rdata": "192.168.0.1", "ttl": 3600, "record_hash": "hash1"}
+	removed := map[string]interface{}{"domain": "www.example.com", "rtype": "A", "rdata": "192.168.0.2", "ttl": 3600, "record_hash": "hash2"}
+	added := map[string]interface{}{"domain": "www.example.com", "rtype": "A", "rdata": "192.168.0.3", "ttl": 3600}
+
+	oldItems := schema.NewSet(dnsRecordItemHashCodeForSets, []interface{}{unchanged, removed})
+	newItems := schema.NewSet(dnsRecordItemHashCodeForSets, []interface{}{
+		map[string]interface{}{"domain": "www.example.com", "rtype": "A", "rdata": "192.168.0.1", "ttl": 3600},
+		added,
+	})
+
+	operations := getDnsRecordOperations(oldItems, newItems)
+	if len(operations) != 2 {
+		t.Fatalf("expected 2 operations, got %d", len(operations))
+	}
+
+	if operations[0].Operation != oci_dns.RecordOperationOperationRemove || operations[0].RecordHash == nil || *operations[0].RecordHash != "hash2" {
+		t.Errorf("expected the removed record to be removed by its record hash, got %v", operations[0])
+	}
+
+	if operations[1].Operation != oci_dns.RecordOperationOperationAdd || operations[1].Rdata == nil || *operations[1].Rdata != "192.168.0.3" ||
+		operations[1].Ttl == nil || *operations[1].Ttl != 3600 {
+		t.Errorf("expected the new record to be added, got %v", operations[1])
+	}
+
+	if operations := getDnsRecordOperations(oldItems, oldItems); len(operations) != 0 {
+		t.Errorf("expected no operations for unchanged records, got %d", len(operations))
+	}
+}
@@ -1,10 +1,12 @@
 package provider
 
 import (
+	"context"
 	"strconv"
 	"time"
 
 	oci_common "github.com/oracle/oci-go-sdk/common"
+	oci_kms "github.com/oracle/oci-go-sdk/keymanagement"
 
 	"github.com/terraform-providers/terraform-provider-oci/httpreplay"
 )
@@ -42,3 +44,25 @@ func getKmsNextRetryDuration(response oci_common.OCIOperationResponse, disableNo
 	}
 	return defaultRetryTime
 }
+
+func getKmsKeyCurrentVersionId(clients *OracleClients, managementEndpoint string, keyId string) (string, error) {
+	client, err := clients.KmsManagementClient(managementEndpoint)
+	if err != nil {
+		return "", err
+	}
+
+	request := oci_kms.GetKeyRequest{}
+	request.KeyId = &keyId
+
+	request.RequestMetadata.RetryPolicy = kmsGetRetryPolicy(false, "kms")
+
+	response, err := client.GetKey(context.Background(), request)
+	if err != nil {
+		return "", err
+	}
+
+	if response.CurrentKeyVersion == nil {
+		return "", nil
+	}
+	return *response.CurrentKeyVersion, nil
+}
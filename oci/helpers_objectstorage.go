@@ -5,11 +5,18 @@ package provider
 import (
 	"bytes"
 	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"log"
 	"os"
+	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -27,6 +34,9 @@ const defaultNumberOfGoroutines = 10
 const maxPartSize int64 = 50 * 1024 * 1024 * 1024
 const maxCount int64 = 10000
 
+// The multi part uploads created by the provider are recorded there, only those can be resumed
+var multipartUploadRecordsDir = filepath.Join(os.TempDir(), "terraform-provider-oci", "multipart-uploads")
+
 type MultipartUploadData struct {
 	NamespaceName       *string                                 `mandatory:"true"`
 	BucketName          *string                                 `mandatory:"true"`
@@ -43,6 +53,11 @@ type MultipartUploadData struct {
 	RequestMetadata     common.RequestMetadata
 }
 
+type multipartUploadRecord struct {
+	UploadId    string `json:"uploadId"`
+	Fingerprint string `json:"fingerprint"`
+}
+
 type objectStorageUploadPartResponse struct {
 	response   oci_object_storage.UploadPartResponse
 	partNumber *int
@@ -50,17 +65,18 @@ type objectStorageUploadPartResponse struct {
 }
 
 type objectStorageMultiPartUploadContext struct {
-	client                  oci_object_storage.ObjectStorageClient
-	sourceBlocks            chan objectStorageSourceBlock
-	osUploadPartResponses   chan objectStorageUploadPartResponse
-	wg                      *sync.WaitGroup
-	multipartUploadResponse oci_object_storage.CreateMultipartUploadResponse
-	multipartUploadRequest  oci_object_storage.CreateMultipartUploadRequest
+	client                 oci_object_storage.ObjectStorageClient
+	sourceBlocks           chan objectStorageSourceBlock
+	osUploadPartResponses  chan objectStorageUploadPartResponse
+	wg                     *sync.WaitGroup
+	multipartUpload        oci_object_storage.MultipartUpload
+	multipartUploadRequest oci_object_storage.CreateMultipartUploadRequest
 }
 
 type objectStorageSourceBlock struct {
 	section     *io.SectionReader
 	blockNumber *int
+	md5         *string
 }
 
 func resourceObjectStorageMapToMetadata(rm map[string]interface{}) map[string]string {
@@ -204,11 +220,38 @@ func multiPartUploadImpl(multipartUploadData MultipartUploadData) (string, error
 		return "", fmt.Errorf("error splitting source file for upload \"%v\": %s", source, err)
 	}
 
-	multipartUploadResponse, err := client.CreateMultipartUpload(context.Background(), *multipartUploadRequest)
+	// Resume the upload of the object left behind by an interrupted apply, if it was created with the same properties
+	recordPath := multipartUploadRecordPath(*multipartUploadData.NamespaceName, *multipartUploadData.BucketName, *multipartUploadData.ObjectName)
+	fingerprint := multipartUploadFingerprint(multipartUploadRequest.CreateMultipartUploadDetails)
+	multipartUpload, err := findRecordedMultipartUpload(client, multipartUploadData, recordPath, fingerprint)
 	if err != nil {
-		return "", fmt.Errorf("error creating object in the Oracle cloud \"%v\": %s", source, err)
+		return "", fmt.Errorf("error listing the multi part uploads of \"%v\": %s", source, err)
+	}
+
+	uploadedParts := map[int]oci_object_storage.MultipartUploadPartSummary{}
+	resumable := true
+	if multipartUpload != nil {
+		log.Printf("[DEBUG] resuming the multi part upload %s of \"%v\"", *multipartUpload.UploadId, *source)
+
+		uploadedParts, err = listMultipartUploadParts(client, *multipartUpload, multipartUploadRequest.RequestMetadata)
+		if err != nil {
+			return "", fmt.Errorf("error listing the uploaded parts of \"%v\": %s", source, err)
+		}
+	} else {
+		multipartUploadResponse, err := client.CreateMultipartUpload(context.Background(), *multipartUploadRequest)
+		if err != nil {
+			return "", fmt.Errorf("error creating object in the Oracle cloud \"%v\": %s", source, err)
+		}
+		multipartUpload = &multipartUploadResponse.MultipartUpload
+
+		if err := writeMultipartUploadRecord(recordPath, multipartUploadRecord{UploadId: *multipartUpload.UploadId, Fingerprint: fingerprint}); err != nil {
+			log.Printf("[WARN] Recording the multi part upload %s failed, it cannot be resumed: %s", *multipartUpload.UploadId, err)
+			resumable = false
+		}
 	}
 
+	sourceBlocks, commitMultipartUploadPartDetails := filterUploadedParts(sourceBlocks, uploadedParts)
+
 	workerCount := defaultNumberOfGoroutines
 
 	osUploadPartResponses := make(chan objectStorageUploadPartResponse, len(sourceBlocks))
@@ -224,12 +267,12 @@ func multiPartUploadImpl(multipartUploadData MultipartUploadData) (string, error
 
 	for i := 0; i < workerCount; i++ {
 		go uploadPartsWorker(objectStorageMultiPartUploadContext{
-			client:                  *client,
-			wg:                      wg,
-			multipartUploadResponse: multipartUploadResponse,
-			multipartUploadRequest:  *multipartUploadRequest,
-			sourceBlocks:            sourceBlocksChan,
-			osUploadPartResponses:   osUploadPartResponses,
+			client:                 *client,
+			wg:                     wg,
+			multipartUpload:        *multipartUpload,
+			multipartUploadRequest: *multipartUploadRequest,
+			sourceBlocks:           sourceBlocksChan,
+			osUploadPartResponses:  osUploadPartResponses,
 		})
 	}
 
@@ -237,9 +280,6 @@ func multiPartUploadImpl(multipartUploadData MultipartUploadData) (string, error
 
 	close(osUploadPartResponses)
 
-	commitMultipartUploadPartDetails := make([]oci_object_storage.CommitMultipartUploadPartDetails, len(sourceBlocks))
-
-	osResponseIndex := 0
 	var uploadPartRespErr error
 	for osUploadPartResponse := range osUploadPartResponses {
 		if osUploadPartResponse.error != nil {
@@ -247,39 +287,27 @@ func multiPartUploadImpl(multipartUploadData MultipartUploadData) (string, error
 			break
 		}
 
-		commitMultipartUploadPartDetails[osResponseIndex] = oci_object_storage.CommitMultipartUploadPartDetails{
+		commitMultipartUploadPartDetails = append(commitMultipartUploadPartDetails, oci_object_storage.CommitMultipartUploadPartDetails{
 			PartNum: osUploadPartResponse.partNumber,
 			Etag:    osUploadPartResponse.response.ETag,
-		}
-		osResponseIndex++
+		})
 	}
 
 	if uploadPartRespErr != nil {
-		// just aborting the multi upload for now; but the service itself will handle the same request again
-		abortMultipartUploadRequest := oci_object_storage.AbortMultipartUploadRequest{
-			NamespaceName:      multipartUploadResponse.Namespace,
-			BucketName:         multipartUploadResponse.Bucket,
-			ObjectName:         multipartUploadResponse.Object,
-			UploadId:           multipartUploadResponse.MultipartUpload.UploadId,
-			OpcClientRequestId: multipartUploadResponse.OpcClientRequestId,
-			RequestMetadata:    multipartUploadRequest.RequestMetadata,
+		// the upload is only aborted when it cannot be resumed, otherwise the parts uploaded so far are reused by the next
+		// apply on this machine, or aborted by the next apply on another one
+		if !resumable {
+			abortMultipartUpload(client, *multipartUpload, multipartUploadRequest.RequestMetadata)
 		}
-
-		_, err := client.AbortMultipartUpload(context.Background(), abortMultipartUploadRequest)
-
-		if err != nil {
-			log.Println("[WARN] Aborting the multi part upload failed")
-		}
-
 		return "", fmt.Errorf("failed to upload object parts of \"%v\" to the Oracle cloud: %s", source, uploadPartRespErr)
 	}
 
 	commitMultipartUploadRequest := oci_object_storage.CommitMultipartUploadRequest{
-		UploadId:           multipartUploadResponse.MultipartUpload.UploadId,
-		NamespaceName:      multipartUploadResponse.Namespace,
-		BucketName:         multipartUploadResponse.Bucket,
-		ObjectName:         multipartUploadResponse.Object,
-		OpcClientRequestId: multipartUploadResponse.OpcClientRequestId,
+		UploadId:           multipartUpload.UploadId,
+		NamespaceName:      multipartUpload.Namespace,
+		BucketName:         multipartUpload.Bucket,
+		ObjectName:         multipartUpload.Object,
+		OpcClientRequestId: multipartUploadData.OpcClientRequestID,
 		RequestMetadata:    multipartUploadRequest.RequestMetadata,
 	}
 	commitMultipartUploadRequest.PartsToCommit = commitMultipartUploadPartDetails
@@ -289,11 +317,199 @@ func multiPartUploadImpl(multipartUploadData MultipartUploadData) (string, error
 		return "", fmt.Errorf("failed to commit multi part upload of \"%v\" to the service: %s", source, err)
 	}
 
+	removeMultipartUploadRecord(recordPath)
+
 	id := getObjectCompositeId(*commitMultipartUploadRequest.BucketName, *commitMultipartUploadRequest.NamespaceName, *commitMultipartUploadRequest.ObjectName)
 
 	return id, nil
 }
 
+// findRecordedMultipartUpload returns the in-progress upload of the object recorded by a previous apply, when it was
+// created with the same properties. The other in-progress uploads of the object cannot be resumed, they were started with
+// other properties, on another machine or by another client, and are aborted since the object is about to be replaced.
+func findRecordedMultipartUpload(client *oci_object_storage.ObjectStorageClient, multipartUploadData MultipartUploadData, recordPath string, fingerprint string) (*oci_object_storage.MultipartUpload, error) {
+	record, err := readMultipartUploadRecord(recordPath)
+	if err != nil {
+		log.Printf("[WARN] Ignoring the multi part upload record %s: %s", recordPath, err)
+		record = nil
+	}
+
+	multipartUploads, err := listObjectMultipartUploads(client, multipartUploadData)
+	if err != nil {
+		return nil, err
+	}
+
+	var recordedMultipartUpload *oci_object_storage.MultipartUpload
+	for i, multipartUpload := range multipartUploads {
+		if record != nil && *multipartUpload.UploadId == record.UploadId && record.Fingerprint == fingerprint {
+			recordedMultipartUpload = &multipartUploads[i]
+			continue
+		}
+
+		log.Printf("[DEBUG] the multi part upload %s of the object cannot be resumed, aborting it", *multipartUpload.UploadId)
+		abortMultipartUpload(client, multipartUpload, multipartUploadData.RequestMetadata)
+	}
+
+	return recordedMultipartUpload, nil
+}
+
+func listObjectMultipartUploads(client *oci_object_storage.ObjectStorageClient, multipartUploadData MultipartUploadData) ([]oci_object_storage.MultipartUpload, error) {
+	request := oci_object_storage.ListMultipartUploadsRequest{
+		NamespaceName:   multipartUploadData.NamespaceName,
+		BucketName:      multipartUploadData.BucketName,
+		RequestMetadata: multipartUploadData.RequestMetadata,
+	}
+
+	multipartUploads := []oci_object_storage.MultipartUpload{}
+	for {
+		response, err := client.ListMultipartUploads(context.Background(), request)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range response.Items {
+			if item.Object != nil && *item.Object == *multipartUploadData.ObjectName && item.UploadId != nil {
+				multipartUploads = append(multipartUploads, item)
+			}
+		}
+
+		if response.OpcNextPage == nil {
+			return multipartUploads, nil
+		}
+		request.Page = response.OpcNextPage
+	}
+}
+
+func multipartUploadRecordPath(namespace string, bucket string, object string) string {
+	sum := sha256.Sum256([]byte(namespace + "/" + bucket + "/" + object))
+	return filepath.Join(multipartUploadRecordsDir, hex.EncodeToString(sum[:])+".json")
+}
+
+// multipartUploadFingerprint identifies the properties an upload is created with, a resumed upload keeps them
+func multipartUploadFingerprint(details oci_object_storage.CreateMultipartUploadDetails) string {
+	hash := sha256.New()
+	for _, property := range []*string{details.ContentType, details.ContentLanguage, details.ContentEncoding} {
+		if property != nil {
+			fmt.Fprintf(hash, "%q\n", *property)
+		} else {
+			fmt.Fprintln(hash)
+		}
+	}
+
+	keys := []string{}
+	for key := range details.Metadata {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		fmt.Fprintf(hash, "%q=%q\n", key, details.Metadata[key])
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func readMultipartUploadRecord(path string) (*multipartUploadRecord, error) {
+	content, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	record := &multipartUploadRecord{}
+	if err := json.Unmarshal(content, record); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+func writeMultipartUploadRecord(path string, record multipartUploadRecord) error {
+	content, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, content, 0600)
+}
+
+func removeMultipartUploadRecord(path string) {
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		log.Printf("[WARN] Removing the multi part upload record %s failed: %s", path, err)
+	}
+}
+
+func listMultipartUploadParts(client *oci_object_storage.ObjectStorageClient, multipartUpload oci_object_storage.MultipartUpload, requestMetadata common.RequestMetadata) (map[int]oci_object_storage.MultipartUploadPartSummary, error) {
+	request := oci_object_storage.ListMultipartUploadPartsRequest{
+		NamespaceName:   multipartUpload.Namespace,
+		BucketName:      multipartUpload.Bucket,
+		ObjectName:      multipartUpload.Object,
+		UploadId:        multipartUpload.UploadId,
+		RequestMetadata: requestMetadata,
+	}
+
+	parts := map[int]oci_object_storage.MultipartUploadPartSummary{}
+	for {
+		response, err := client.ListMultipartUploadParts(context.Background(), request)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, part := range response.Items {
+			if part.PartNumber != nil {
+				parts[*part.PartNumber] = part
+			}
+		}
+
+		if response.OpcNextPage == nil {
+			break
+		}
+		request.Page = response.OpcNextPage
+	}
+
+	return parts, nil
+}
+
+// filterUploadedParts returns the source blocks that still have to be uploaded and the uploaded parts that match the
+// source. Parts that do not match are uploaded again, which replaces them in the upload.
+func filterUploadedParts(sourceBlocks []objectStorageSourceBlock, uploadedParts map[int]oci_object_storage.MultipartUploadPartSummary) ([]objectStorageSourceBlock, []oci_object_storage.CommitMultipartUploadPartDetails) {
+	remainingBlocks := []objectStorageSourceBlock{}
+	commitMultipartUploadPartDetails := []oci_object_storage.CommitMultipartUploadPartDetails{}
+
+	for _, sourceBlock := range sourceBlocks {
+		part, ok := uploadedParts[*sourceBlock.blockNumber]
+		if ok && part.Md5 != nil && part.Size != nil && part.Etag != nil &&
+			*part.Md5 == *sourceBlock.md5 && *part.Size == sourceBlock.section.Size() {
+			commitMultipartUploadPartDetails = append(commitMultipartUploadPartDetails, oci_object_storage.CommitMultipartUploadPartDetails{
+				PartNum: sourceBlock.blockNumber,
+				Etag:    part.Etag,
+			})
+			continue
+		}
+
+		remainingBlocks = append(remainingBlocks, sourceBlock)
+	}
+
+	return remainingBlocks, commitMultipartUploadPartDetails
+}
+
+func abortMultipartUpload(client *oci_object_storage.ObjectStorageClient, multipartUpload oci_object_storage.MultipartUpload, requestMetadata common.RequestMetadata) {
+	abortMultipartUploadRequest := oci_object_storage.AbortMultipartUploadRequest{
+		NamespaceName:   multipartUpload.Namespace,
+		BucketName:      multipartUpload.Bucket,
+		ObjectName:      multipartUpload.Object,
+		UploadId:        multipartUpload.UploadId,
+		RequestMetadata: requestMetadata,
+	}
+
+	if _, err := client.AbortMultipartUpload(context.Background(), abortMultipartUploadRequest); err != nil {
+		log.Printf("[WARN] Aborting the stale multi part upload %s failed: %s", *multipartUpload.UploadId, err)
+	}
+}
+
 func objectMultiPartSplit(file *os.File) ([]objectStorageSourceBlock, error) {
 
 	info, err := file.Stat()
@@ -302,12 +518,26 @@ func objectMultiPartSplit(file *os.File) ([]objectStorageSourceBlock, error) {
 	}
 
 	offsets, limits, err := splitSizeToOffsetsAndLimits(info.Size())
+	if err != nil {
+		return nil, err
+	}
+
 	sourceBlocks := make([]objectStorageSourceBlock, len(offsets))
 	for index := 0; index < len(offsets); index++ {
 		tmpIndex := index + 1
+		section := io.NewSectionReader(file, offsets[index], limits[index])
+
+		// The MD5 of each part is compared with the parts already uploaded and with the one computed by the service
+		hash := md5.New()
+		if _, err := io.Copy(hash, io.NewSectionReader(section, 0, section.Size())); err != nil {
+			return nil, fmt.Errorf("failed to compute the MD5 of the source %q part %v: %s", file.Name(), tmpIndex, err)
+		}
+		tmpMd5 := base64.StdEncoding.EncodeToString(hash.Sum(nil))
+
 		sourceBlocks[index] = objectStorageSourceBlock{
-			section:     io.NewSectionReader(file, offsets[index], limits[index]),
+			section:     section,
 			blockNumber: &tmpIndex,
+			md5:         &tmpMd5,
 		}
 	}
 
@@ -376,17 +606,21 @@ func uploadPartsWorker(ctx objectStorageMultiPartUploadContext) {
 		}
 		tmpLength := int64(len(block))
 		uploadPartRequest := &oci_object_storage.UploadPartRequest{
-			UploadId:        ctx.multipartUploadResponse.UploadId,
-			ObjectName:      ctx.multipartUploadResponse.Object,
-			NamespaceName:   ctx.multipartUploadResponse.Namespace,
-			BucketName:      ctx.multipartUploadResponse.Bucket,
+			UploadId:        ctx.multipartUpload.UploadId,
+			ObjectName:      ctx.multipartUpload.Object,
+			NamespaceName:   ctx.multipartUpload.Namespace,
+			BucketName:      ctx.multipartUpload.Bucket,
 			RequestMetadata: ctx.multipartUploadRequest.RequestMetadata,
 			ContentLength:   &tmpLength,
+			ContentMD5:      sourceBlock.md5,
 			UploadPartBody:  ioutil.NopCloser(bytes.NewBuffer(block)),
 			UploadPartNum:   sourceBlock.blockNumber,
 		}
 
 		uploadPartResponse, err := ctx.client.UploadPart(context.Background(), *uploadPartRequest)
+		if err == nil && (uploadPartResponse.OpcContentMd5 == nil || *uploadPartResponse.OpcContentMd5 != *sourceBlock.md5) {
+			err = fmt.Errorf("the MD5 returned by the service for part %v does not match the MD5 %s of the source", *sourceBlock.blockNumber, *sourceBlock.md5)
+		}
 
 		osUploadPartResponse := &objectStorageUploadPartResponse{
 			response:   uploadPartResponse,
@@ -3,7 +3,15 @@
 package provider
 
 import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+
+	"github.com/oracle/oci-go-sdk/common"
+	oci_object_storage "github.com/oracle/oci-go-sdk/objectstorage"
 )
 
 func TestUnitSafe_splitSizeToOffsetsAndLimits(t *testing.T) {
@@ -70,3 +78,101 @@ func TestUnitSafe_splitSizeToOffsetsAndLimits(t *testing.T) {
 
 	return
 }
+
+func TestUnitSafe_filterUploadedParts(t *testing.T) {
+	source := strings.NewReader("part1part2part3")
+
+	sourceBlocks := []objectStorageSourceBlock{}
+	for index, md5 := range []string{"md5-1", "md5-2", "md5-3"} {
+		blockNumber := index + 1
+		tmpMd5 := md5
+		sourceBlocks = append(sourceBlocks, objectStorageSourceBlock{
+			section:     io.NewSectionReader(source, int64(index*5), 5),
+			blockNumber: &blockNumber,
+			md5:         &tmpMd5,
+		})
+	}
+
+	uploadedParts := map[int]oci_object_storage.MultipartUploadPartSummary{
+		// matches the source
+		1: {PartNumber: common.Int(1), Md5: common.String("md5-1"), Size: common.Int64(5), Etag: common.String("etag-1")},
+		// the source changed since the part was uploaded
+		2: {PartNumber: common.Int(2), Md5: common.String("md5-old"), Size: common.Int64(5), Etag: common.String("etag-2")},
+	}
+
+	remainingBlocks, commitMultipartUploadPartDetails := filterUploadedParts(sourceBlocks, uploadedParts)
+
+	if len(remainingBlocks) != 2 || *remainingBlocks[0].blockNumber != 2 || *remainingBlocks[1].blockNumber != 3 {
+		t.Errorf("Parts 2 and 3 should be uploaded, got %v parts to upload", len(remainingBlocks))
+		return
+	}
+
+	if len(commitMultipartUploadPartDetails) != 1 || *commitMultipartUploadPartDetails[0].PartNum != 1 || *commitMultipartUploadPartDetails[0].Etag != "etag-1" {
+		t.Errorf("Part 1 should be reused, got %v parts to reuse", len(commitMultipartUploadPartDetails))
+		return
+	}
+
+	remainingBlocks, commitMultipartUploadPartDetails = filterUploadedParts(sourceBlocks, map[int]oci_object_storage.MultipartUploadPartSummary{})
+	if len(remainingBlocks) != 3 || len(commitMultipartUploadPartDetails) != 0 {
+		t.Errorf("All the parts should be uploaded for a new upload")
+		return
+	}
+}
+
+func TestUnitMultipartUploadRecord(t *testing.T) {
+	recordsDir, err := ioutil.TempDir("", "multipart-uploads")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	defer os.RemoveAll(recordsDir)
+	path := filepath.Join(recordsDir, "records", "record.json")
+
+	if record, err := readMultipartUploadRecord(path); record != nil || err != nil {
+		t.Errorf("Expected no record, got %v, %v", record, err)
+	}
+
+	expected := multipartUploadRecord{UploadId: "upload-1", Fingerprint: "fingerprint"}
+	if err := writeMultipartUploadRecord(path, expected); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if record, err := readMultipartUploadRecord(path); err != nil || record == nil || *record != expected {
+		t.Errorf("Expected the record %v, got %v, %v", expected, record, err)
+	}
+
+	removeMultipartUploadRecord(path)
+	if record, err := readMultipartUploadRecord(path); record != nil || err != nil {
+		t.Errorf("Expected the record to be removed, got %v, %v", record, err)
+	}
+
+	if multipartUploadRecordPath("namespace", "bucket", "object-1") == multipartUploadRecordPath("namespace", "bucket", "object-2") {
+		t.Errorf("Expected the uploads of different objects to be recorded separately")
+	}
+}
+
+func TestUnitMultipartUploadFingerprint(t *testing.T) {
+	details := oci_object_storage.CreateMultipartUploadDetails{
+		Object:      common.String("object"),
+		ContentType: common.String("text/plain"),
+		Metadata:    map[string]string{"opc-meta-a": "1", "opc-meta-b": "2"},
+	}
+	fingerprint := multipartUploadFingerprint(details)
+
+	same := details
+	same.Metadata = map[string]string{"opc-meta-b": "2", "opc-meta-a": "1"}
+	if multipartUploadFingerprint(same) != fingerprint {
+		t.Errorf("Expected the fingerprint to not depend on the order of the metadata")
+	}
+
+	changes := []oci_object_storage.CreateMultipartUploadDetails{
+		{Object: details.Object, ContentType: common.String("application/json"), Metadata: details.Metadata},
+		{Object: details.Object, Metadata: details.Metadata},
+		{Object: details.Object, ContentType: details.ContentType, ContentLanguage: common.String("en-US"), Metadata: details.Metadata},
+		{Object: details.Object, ContentType: details.ContentType, Metadata: map[string]string{"opc-meta-a": "1"}},
+		{Object: details.Object, ContentType: details.ContentType, Metadata: map[string]string{"opc-meta-a": "1", "opc-meta-b": "3"}},
+	}
+	for _, changed := range changes {
+		if multipartUploadFingerprint(changed) == fingerprint {
+			t.Errorf("Expected the fingerprint of %v to differ", changed)
+		}
+	}
+}
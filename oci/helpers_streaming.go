@@ -0,0 +1,122 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	oci_streaming "github.com/oracle/oci-go-sdk/streaming"
+)
+
+func getStreamingMessagesCompositeId(streamId string, partition string, offset int64) string {
+	return fmt.Sprintf("streamId/%s/partition/%s/offset/%d", streamId, partition, offset)
+}
+
+// getStreamingPutMessagesError reports every message the service failed to publish, the other messages of the
+// request have been published regardless
+func getStreamingPutMessagesError(result oci_streaming.PutMessagesResult) error {
+	if result.Failures == nil || *result.Failures == 0 {
+		return nil
+	}
+
+	message := fmt.Sprintf("failed to publish %d of %d messages", *result.Failures, len(result.Entries))
+	for i, entry := range result.Entries {
+		if entry.Error == nil {
+			continue
+		}
+		message += fmt.Sprintf("\nmessage %d: %s", i, *entry.Error)
+		if entry.ErrorMessage != nil {
+			message += fmt.Sprintf(": %s", *entry.ErrorMessage)
+		}
+	}
+
+	return fmt.Errorf("%s", message)
+}
+
+// keepLastStreamingMessages appends a page of messages to the ones already read and only keeps the last limit of them
+func keepLastStreamingMessages(messages []oci_streaming.Message, page []oci_streaming.Message, limit int) []oci_streaming.Message {
+	messages = append(messages, page...)
+	if limit > 0 && len(messages) > limit {
+		messages = messages[len(messages)-limit:]
+	}
+
+	return messages
+}
+
+// Reading a stream from TRIM_HORIZON could take very long on a large stream, a read stops after these many pages or this duration
+const (
+	streamingMessagesMaxPages    = 100
+	streamingMessagesMaxReadTime = time.Minute
+)
+
+// readStreamingMessagesFromCursor reads pages of messages from the cursor until the stream has caught up, or until maxPages pages
+// were read or the deadline has passed, and returns the last limit messages read. The read is truncated when it stopped before
+// the stream had caught up, the messages returned may then not be the last ones of the stream.
+func readStreamingMessagesFromCursor(cursor *string, limit int, maxPages int, deadline time.Time, getMessages func(cursor *string) ([]oci_streaming.Message, *string, error)) (messages []oci_streaming.Message, truncated bool, err error) {
+	messages = []oci_streaming.Message{}
+	for pages := 0; cursor != nil; pages++ {
+		if pages >= maxPages || time.Now().After(deadline) {
+			log.Printf("[WARN] stopped reading the stream after %d pages, the messages returned may not be the last ones of the stream", pages)
+			return messages, true, nil
+		}
+
+		page, nextCursor, err := getMessages(cursor)
+		if err != nil {
+			return nil, false, err
+		}
+
+		if len(page) == 0 {
+			break
+		}
+		messages = keepLastStreamingMessages(messages, page, limit)
+		cursor = nextCursor
+	}
+
+	return messages, false, nil
+}
+
+// Consumer groups track their own position in the stream, their cursors can't start at an offset
+var streamingGroupCursorTypes = []string{
+	string(oci_streaming.CreateGroupCursorDetailsTypeAtTime),
+	string(oci_streaming.CreateGroupCursorDetailsTypeLatest),
+	string(oci_streaming.CreateGroupCursorDetailsTypeTrimHorizon),
+}
+
+func validateStreamingGroupCursorType(cursorType string) error {
+	for _, groupCursorType := range streamingGroupCursorTypes {
+		if cursorType == groupCursorType {
+			return nil
+		}
+	}
+	return fmt.Errorf("type %s is not supported when reading from a consumer group, expected one of %s", cursorType, strings.Join(streamingGroupCursorTypes, ", "))
+}
+
+func StreamingMessageToMap(obj oci_streaming.Message) map[string]interface{} {
+	result := map[string]interface{}{}
+
+	result["key"] = string(obj.Key)
+
+	if obj.Offset != nil {
+		result["offset"] = strconv.FormatInt(*obj.Offset, 10)
+	}
+
+	if obj.Partition != nil {
+		result["partition"] = string(*obj.Partition)
+	}
+
+	if obj.Stream != nil {
+		result["stream"] = string(*obj.Stream)
+	}
+
+	if obj.Timestamp != nil {
+		result["timestamp"] = obj.Timestamp.String()
+	}
+
+	result["value"] = string(obj.Value)
+
+	return result
+}
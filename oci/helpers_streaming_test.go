@@ -0,0 +1,122 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	oci_streaming "github.com/oracle/oci-go-sdk/streaming"
+)
+
+func TestUnitKeepLastStreamingMessages(t *testing.T) {
+	page := func(offsets ...int64) []oci_streaming.Message {
+		messages := []oci_streaming.Message{}
+		for i := range offsets {
+			messages = append(messages, oci_streaming.Message{Offset: &offsets[i]})
+		}
+		return messages
+	}
+
+	messages := keepLastStreamingMessages(nil, page(0, 1, 2), 4)
+	if len(messages) != 3 {
+		t.Fatalf("expected 3 messages, got %d", len(messages))
+	}
+
+	messages = keepLastStreamingMessages(messages, page(3, 4, 5), 4)
+	if len(messages) != 4 {
+		t.Fatalf("expected 4 messages, got %d", len(messages))
+	}
+	if *messages[0].Offset != 2 || *messages[3].Offset != 5 {
+		t.Errorf("expected the messages from offset 2 to 5 to be kept, got %d to %d", *messages[0].Offset, *messages[3].Offset)
+	}
+}
+
+func TestUnitGetStreamingPutMessagesError(t *testing.T) {
+	failures := 0
+	partition := "0"
+	offset := int64(10)
+	result := oci_streaming.PutMessagesResult{
+		Failures: &failures,
+		Entries:  []oci_streaming.PutMessagesResultEntry{{Partition: &partition, Offset: &offset}},
+	}
+	if err := getStreamingPutMessagesError(result); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+
+	failures = 1
+	errorCode := "429"
+	errorMessage := "Throttled"
+	result.Entries = append(result.Entries, oci_streaming.PutMessagesResultEntry{Error: &errorCode, ErrorMessage: &errorMessage})
+	err := getStreamingPutMessagesError(result)
+	if err == nil {
+		t.Fatalf("expected an error for the failed message")
+	}
+	if !strings.Contains(err.Error(), "failed to publish 1 of 2 messages") || !strings.Contains(err.Error(), "message 1: 429: Throttled") {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestUnitReadStreamingMessagesFromCursor(t *testing.T) {
+	pages := 0
+	getMessages := func(cursor *string) ([]oci_streaming.Message, *string, error) {
+		pages++
+		offset := int64(pages)
+		nextCursor := fmt.Sprintf("cursor-%d", pages)
+		if pages > 3 {
+			return []oci_streaming.Message{}, &nextCursor, nil
+		}
+		return []oci_streaming.Message{{Offset: &offset}}, &nextCursor, nil
+	}
+	cursor := "cursor-0"
+
+	// The stream is read until it has caught up
+	messages, truncated, err := readStreamingMessagesFromCursor(&cursor, 2, 100, time.Now().Add(time.Minute), getMessages)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if truncated {
+		t.Errorf("expected the read not to be truncated once the stream has caught up")
+	}
+	if pages != 4 || len(messages) != 2 || *messages[1].Offset != 3 {
+		t.Errorf("expected the last 2 of 3 messages after reading 4 pages, got %d messages after %d pages", len(messages), pages)
+	}
+
+	// The read stops after maxPages pages
+	pages = 0
+	messages, truncated, err = readStreamingMessagesFromCursor(&cursor, 10, 2, time.Now().Add(time.Minute), getMessages)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pages != 2 || len(messages) != 2 || !truncated {
+		t.Errorf("expected 2 messages after reading 2 pages, got %d messages after %d pages", len(messages), pages)
+	}
+
+	// The read stops once the deadline has passed
+	pages = 0
+	if messages, truncated, _ = readStreamingMessagesFromCursor(&cursor, 10, 100, time.Now().Add(-time.Second), getMessages); pages != 0 || len(messages) != 0 || !truncated {
+		t.Errorf("expected no page to be read after the deadline, got %d pages", pages)
+	}
+
+	getMessagesError := func(cursor *string) ([]oci_streaming.Message, *string, error) {
+		return nil, nil, fmt.Errorf("stream not found")
+	}
+	if _, _, err := readStreamingMessagesFromCursor(&cursor, 10, 100, time.Now().Add(time.Minute), getMessagesError); err == nil {
+		t.Errorf("expected the error of the service")
+	}
+}
+
+func TestUnitValidateStreamingGroupCursorType(t *testing.T) {
+	for _, cursorType := range []string{"TRIM_HORIZON", "AT_TIME", "LATEST"} {
+		if err := validateStreamingGroupCursorType(cursorType); err != nil {
+			t.Errorf("unexpected error for %s: %v", cursorType, err)
+		}
+	}
+	for _, cursorType := range []string{"AT_OFFSET", "AFTER_OFFSET"} {
+		if err := validateStreamingGroupCursorType(cursorType); err == nil {
+			t.Errorf("expected an error for %s", cursorType)
+		}
+	}
+}
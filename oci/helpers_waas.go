@@ -3,6 +3,9 @@
 package provider
 
 import (
+	"fmt"
+	"net/url"
+	"regexp"
 	"strings"
 	"time"
 
@@ -14,6 +17,10 @@ const (
 	waasDeleteConflictRetryDuration = 60 * time.Minute
 )
 
+// The protection rules, threat feeds and recommendations of a policy are all updated through work requests on the
+// policy, use a per-policy mutex so that they are not updated concurrently
+var waasPolicyMutexes SafeMutexMap
+
 var waasServiceExpectedRetryDurationMap = map[string]serviceExpectedRetryDurationFunc{
 	certificateService: getWaasCertificateExpectedRetryDuration,
 }
@@ -51,3 +58,23 @@ func getWaasCertificateExpectedRetryDuration(response oci_common.OCIOperationRes
 	return defaultRetryTime
 
 }
+
+func getWaasPolicyChildCompositeId(waasPolicyId string, childType string, key string) string {
+	waasPolicyId = url.PathEscape(waasPolicyId)
+	key = url.PathEscape(key)
+	compositeId := "waasPolicies/" + waasPolicyId + "/" + childType + "/" + key
+	return compositeId
+}
+
+func parseWaasPolicyChildCompositeId(compositeId string, childType string) (waasPolicyId string, key string, err error) {
+	parts := strings.Split(compositeId, "/")
+	match, _ := regexp.MatchString("^waasPolicies/[^/]+/"+childType+"/[^/]+$", compositeId)
+	if !match || len(parts) != 4 {
+		err = fmt.Errorf("illegal compositeId %s encountered, expected format: waasPolicies/{waasPolicyId}/%s/{key}", compositeId, childType)
+		return
+	}
+	waasPolicyId, _ = url.PathUnescape(parts[1])
+	key, _ = url.PathUnescape(parts[3])
+
+	return
+}
@@ -0,0 +1,33 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"testing"
+)
+
+func TestUnitParseWaasPolicyChildCompositeId(t *testing.T) {
+	waasPolicyId := "ocid1.waaspolicy.oc1..aaaa"
+	key := "950351"
+
+	compositeId := getWaasPolicyChildCompositeId(waasPolicyId, "protectionRules", key)
+	if compositeId != "waasPolicies/ocid1.waaspolicy.oc1..aaaa/protectionRules/950351" {
+		t.Errorf("unexpected compositeId %s", compositeId)
+	}
+
+	parsedWaasPolicyId, parsedKey, err := parseWaasPolicyChildCompositeId(compositeId, "protectionRules")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if parsedWaasPolicyId != waasPolicyId || parsedKey != key {
+		t.Errorf("expected %s and %s, got %s and %s", waasPolicyId, key, parsedWaasPolicyId, parsedKey)
+	}
+
+	if _, _, err := parseWaasPolicyChildCompositeId(compositeId, "threatFeeds"); err == nil {
+		t.Errorf("expected an error when parsing a protection rule compositeId as a threat feed")
+	}
+
+	if _, _, err := parseWaasPolicyChildCompositeId(waasPolicyId, "protectionRules"); err == nil {
+		t.Errorf("expected an error when parsing a bare waas policy id")
+	}
+}
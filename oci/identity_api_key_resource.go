@@ -5,8 +5,11 @@ package provider
 import (
 	"context"
 	"errors"
+	"fmt"
+	"net/url"
 	"regexp"
 	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
 
@@ -15,6 +18,9 @@ import (
 
 func IdentityApiKeyResource() *schema.Resource {
 	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: importIdentityApiKey,
+		},
 		Timeouts: DefaultTimeout,
 		Create:   createIdentityApiKey,
 		Read:     readIdentityApiKey,
@@ -195,6 +201,11 @@ func (s *IdentityApiKeyResourceCrud) Delete() error {
 }
 
 func (s *IdentityApiKeyResourceCrud) SetData() error {
+	// An imported key is identified by its fingerprint until the key ID is known
+	if s.Res.KeyId != nil {
+		s.D.SetId(*s.Res.KeyId)
+	}
+
 	if s.Res.Fingerprint != nil {
 		s.D.Set("fingerprint", *s.Res.Fingerprint)
 	}
@@ -219,3 +230,37 @@ func (s *IdentityApiKeyResourceCrud) SetData() error {
 
 	return nil
 }
+
+func importIdentityApiKey(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	userId, fingerprint, err := parseApiKeyCompositeId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(fingerprint)
+	if err := d.Set("fingerprint", fingerprint); err != nil {
+		return nil, err
+	}
+	err = d.Set("user_id", userId)
+	return []*schema.ResourceData{d}, err
+}
+
+func getApiKeyCompositeId(userId string, fingerprint string) string {
+	userId = url.PathEscape(userId)
+	fingerprint = url.PathEscape(fingerprint)
+	compositeId := "users/" + userId + "/apiKeys/" + fingerprint
+	return compositeId
+}
+
+func parseApiKeyCompositeId(compositeId string) (userId string, fingerprint string, err error) {
+	parts := strings.Split(compositeId, "/")
+	match, _ := regexp.MatchString("^users/[^/]+/apiKeys/[^/]+$", compositeId)
+	if !match || len(parts) != 4 {
+		err = fmt.Errorf("illegal compositeId %s encountered, expected format: users/{userId}/apiKeys/{fingerprint}", compositeId)
+		return
+	}
+	userId, _ = url.PathUnescape(parts[1])
+	fingerprint, _ = url.PathUnescape(parts[3])
+
+	return
+}
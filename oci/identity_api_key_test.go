@@ -102,10 +102,30 @@ func TestIdentityApiKeyResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(datasourceName, "api_keys.0.user_id"),
 				),
 			},
+			// verify resource import
+			{
+				Config:                  config,
+				ImportStateIdFunc:       getIdentityApiKeyImportId(resourceName),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{},
+				ResourceName:            resourceName,
+			},
 		},
 	})
 }
 
+func getIdentityApiKeyImportId(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return getApiKeyCompositeId(rs.Primary.Attributes["user_id"], rs.Primary.Attributes["fingerprint"]), nil
+	}
+}
+
 func testAccCheckIdentityApiKeyDestroy(s *terraform.State) error {
 	noResourceFound := true
 	client := testAccProvider.Meta().(*OracleClients).identityClient
@@ -144,3 +164,16 @@ func testAccCheckIdentityApiKeyDestroy(s *terraform.State) error {
 
 	return nil
 }
+
+func TestUnitParseApiKeyCompositeId(t *testing.T) {
+	userId, fingerprint, err := parseApiKeyCompositeId(getApiKeyCompositeId("ocid1.user.oc1..user", "12:34:56:78"))
+	if err != nil || userId != "ocid1.user.oc1..user" || fingerprint != "12:34:56:78" {
+		t.Errorf("unexpected result %s %s: %v", userId, fingerprint, err)
+	}
+
+	for _, invalidId := range []string{"", "12:34:56:78", "users/ocid1.user.oc1..user/authTokens/ocid1.credential.oc1..token", "users/ocid1.user.oc1..user/apiKeys/12:34/extra"} {
+		if _, _, err := parseApiKeyCompositeId(invalidId); err == nil {
+			t.Errorf("expected an error for compositeId '%s'", invalidId)
+		}
+	}
+}
@@ -5,7 +5,11 @@ package provider
 import (
 	"context"
 	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
 	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
 
@@ -14,6 +18,9 @@ import (
 
 func IdentityAuthTokenResource() *schema.Resource {
 	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: importIdentityAuthToken,
+		},
 		Timeouts: DefaultTimeout,
 		Create:   createIdentityAuthToken,
 		Read:     readIdentityAuthToken,
@@ -253,3 +260,34 @@ func (s *IdentityAuthTokenResourceCrud) SetData() error {
 
 	return nil
 }
+
+func importIdentityAuthToken(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	userId, authTokenId, err := parseAuthTokenCompositeId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(authTokenId)
+	err = d.Set("user_id", userId)
+	return []*schema.ResourceData{d}, err
+}
+
+func getAuthTokenCompositeId(userId string, authTokenId string) string {
+	userId = url.PathEscape(userId)
+	authTokenId = url.PathEscape(authTokenId)
+	compositeId := "users/" + userId + "/authTokens/" + authTokenId
+	return compositeId
+}
+
+func parseAuthTokenCompositeId(compositeId string) (userId string, authTokenId string, err error) {
+	parts := strings.Split(compositeId, "/")
+	match, _ := regexp.MatchString("^users/[^/]+/authTokens/[^/]+$", compositeId)
+	if !match || len(parts) != 4 {
+		err = fmt.Errorf("illegal compositeId %s encountered, expected format: users/{userId}/authTokens/{authTokenId}", compositeId)
+		return
+	}
+	userId, _ = url.PathUnescape(parts[1])
+	authTokenId, _ = url.PathUnescape(parts[3])
+
+	return
+}
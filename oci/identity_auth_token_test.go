@@ -103,10 +103,32 @@ func TestIdentityAuthTokenResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(datasourceName, "tokens.0.user_id"),
 				),
 			},
+			// verify resource import
+			{
+				Config:            config,
+				ImportStateIdFunc: getIdentityAuthTokenImportId(resourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"token",
+				},
+				ResourceName: resourceName,
+			},
 		},
 	})
 }
 
+func getIdentityAuthTokenImportId(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return getAuthTokenCompositeId(rs.Primary.Attributes["user_id"], rs.Primary.ID), nil
+	}
+}
+
 func testAccCheckIdentityAuthTokenDestroy(s *terraform.State) error {
 	noResourceFound := true
 	client := testAccProvider.Meta().(*OracleClients).identityClient
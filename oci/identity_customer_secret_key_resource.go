@@ -5,7 +5,11 @@ package provider
 import (
 	"context"
 	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
 	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
 
@@ -14,6 +18,9 @@ import (
 
 func IdentityCustomerSecretKeyResource() *schema.Resource {
 	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: importIdentityCustomerSecretKey,
+		},
 		Timeouts: DefaultTimeout,
 		Create:   createIdentityCustomerSecretKey,
 		Read:     readIdentityCustomerSecretKey,
@@ -264,3 +271,34 @@ func fromCustomerSecretKeySummary(summary oci_identity.CustomerSecretKeySummary)
 	s.InactiveStatus = summary.InactiveStatus
 	return s
 }
+
+func importIdentityCustomerSecretKey(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	userId, customerSecretKeyId, err := parseCustomerSecretKeyCompositeId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(customerSecretKeyId)
+	err = d.Set("user_id", userId)
+	return []*schema.ResourceData{d}, err
+}
+
+func getCustomerSecretKeyCompositeId(userId string, customerSecretKeyId string) string {
+	userId = url.PathEscape(userId)
+	customerSecretKeyId = url.PathEscape(customerSecretKeyId)
+	compositeId := "users/" + userId + "/customerSecretKeys/" + customerSecretKeyId
+	return compositeId
+}
+
+func parseCustomerSecretKeyCompositeId(compositeId string) (userId string, customerSecretKeyId string, err error) {
+	parts := strings.Split(compositeId, "/")
+	match, _ := regexp.MatchString("^users/[^/]+/customerSecretKeys/[^/]+$", compositeId)
+	if !match || len(parts) != 4 {
+		err = fmt.Errorf("illegal compositeId %s encountered, expected format: users/{userId}/customerSecretKeys/{customerSecretKeyId}", compositeId)
+		return
+	}
+	userId, _ = url.PathUnescape(parts[1])
+	customerSecretKeyId, _ = url.PathUnescape(parts[3])
+
+	return
+}
@@ -107,10 +107,32 @@ func TestIdentityCustomerSecretKeyResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(datasourceName, "customer_secret_keys.0.user_id"),
 				),
 			},
+			// verify resource import
+			{
+				Config:            config,
+				ImportStateIdFunc: getIdentityCustomerSecretKeyImportId(resourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"key",
+				},
+				ResourceName: resourceName,
+			},
 		},
 	})
 }
 
+func getIdentityCustomerSecretKeyImportId(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return getCustomerSecretKeyCompositeId(rs.Primary.Attributes["user_id"], rs.Primary.ID), nil
+	}
+}
+
 func testAccCheckIdentityCustomerSecretKeyDestroy(s *terraform.State) error {
 	noResourceFound := true
 	client := testAccProvider.Meta().(*OracleClients).identityClient
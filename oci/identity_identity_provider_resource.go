@@ -83,6 +83,10 @@ func IdentityIdentityProviderResource() *schema.Resource {
 				Computed: true,
 				Elem:     schema.TypeString,
 			},
+			"scim_client_reset_trigger": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 
 			// Computed
 			"inactive_state": {
@@ -93,6 +97,15 @@ func IdentityIdentityProviderResource() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"scim_client_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"scim_client_secret": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
 			"signing_certificate": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -193,6 +206,16 @@ func (s *IdentityIdentityProviderResourceCrud) Create() error {
 	}
 
 	s.Res = &response.IdentityProvider
+
+	if scimClientResetRequested(s.D) {
+		if err := s.resetScimClient(*response.IdentityProvider.GetId()); err != nil {
+			// Failing the create would leave the identity provider out of the state, it is kept without a trigger so that
+			// the reset is retried on the next apply
+			log.Printf("[WARN] %s, it is retried on the next apply", err)
+			s.D.Set("scim_client_reset_trigger", "")
+		}
+	}
+
 	return nil
 }
 
@@ -228,6 +251,46 @@ func (s *IdentityIdentityProviderResourceCrud) Update() error {
 	}
 
 	s.Res = &response.IdentityProvider
+
+	if scimClientResetRequested(s.D) {
+		if err := s.resetScimClient(s.D.Id()); err != nil {
+			// Keep the previous trigger so that the reset is retried on the next apply
+			oldTrigger, _ := s.D.GetChange("scim_client_reset_trigger")
+			s.D.Set("scim_client_reset_trigger", oldTrigger)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Removing the trigger does not reset the credentials
+func scimClientResetRequested(d *schema.ResourceData) bool {
+	trigger, _ := d.Get("scim_client_reset_trigger").(string)
+	return trigger != "" && d.HasChange("scim_client_reset_trigger")
+}
+
+// resetScimClient generates new credentials for the SCIM client of the identity provider, the old ones stop working.
+// The credentials are only returned by the reset, they are kept in the state.
+func (s *IdentityIdentityProviderResourceCrud) resetScimClient(identityProviderId string) error {
+	request := oci_identity.ResetIdpScimClientRequest{}
+	request.IdentityProviderId = &identityProviderId
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
+
+	response, err := s.Client.ResetIdpScimClient(context.Background(), request)
+	if err != nil {
+		return fmt.Errorf("failed to reset the SCIM client of identity provider %s: %s", identityProviderId, err)
+	}
+
+	if response.ClientId != nil {
+		s.D.Set("scim_client_id", *response.ClientId)
+	}
+
+	if response.ClientSecret != nil {
+		s.D.Set("scim_client_secret", *response.ClientSecret)
+	}
+
 	return nil
 }
 
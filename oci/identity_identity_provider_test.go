@@ -11,6 +11,7 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/oracle/oci-go-sdk/common"
 	oci_identity "github.com/oracle/oci-go-sdk/identity"
@@ -39,16 +40,17 @@ var (
 	}
 
 	identityProviderRepresentation = map[string]interface{}{
-		"compartment_id":      Representation{repType: Required, create: `${var.tenancy_ocid}`},
-		"description":         Representation{repType: Required, create: `description`, update: `description2`},
-		"metadata":            Representation{repType: Required, create: `${file("${var.identity_provider_metadata_file}")}`},
-		"metadata_url":        Representation{repType: Required, create: `metadataUrl`, update: `metadataUrl2`},
-		"name":                Representation{repType: Required, create: `test-idp-saml2-adfs`},
-		"product_type":        Representation{repType: Required, create: `ADFS`},
-		"protocol":            Representation{repType: Required, create: `SAML2`},
-		"defined_tags":        Representation{repType: Optional, create: `${map("${oci_identity_tag_namespace.tag-namespace1.name}.${oci_identity_tag.tag1.name}", "value")}`, update: `${map("${oci_identity_tag_namespace.tag-namespace1.name}.${oci_identity_tag.tag1.name}", "updatedValue")}`},
-		"freeform_attributes": Representation{repType: Optional, create: map[string]string{"clientId": "app_sf3kdjf3"}},
-		"freeform_tags":       Representation{repType: Optional, create: map[string]string{"Department": "Finance"}, update: map[string]string{"Department": "Accounting"}},
+		"compartment_id":            Representation{repType: Required, create: `${var.tenancy_ocid}`},
+		"description":               Representation{repType: Required, create: `description`, update: `description2`},
+		"metadata":                  Representation{repType: Required, create: `${file("${var.identity_provider_metadata_file}")}`},
+		"metadata_url":              Representation{repType: Required, create: `metadataUrl`, update: `metadataUrl2`},
+		"name":                      Representation{repType: Required, create: `test-idp-saml2-adfs`},
+		"product_type":              Representation{repType: Required, create: `ADFS`},
+		"protocol":                  Representation{repType: Required, create: `SAML2`},
+		"defined_tags":              Representation{repType: Optional, create: `${map("${oci_identity_tag_namespace.tag-namespace1.name}.${oci_identity_tag.tag1.name}", "value")}`, update: `${map("${oci_identity_tag_namespace.tag-namespace1.name}.${oci_identity_tag.tag1.name}", "updatedValue")}`},
+		"freeform_attributes":       Representation{repType: Optional, create: map[string]string{"clientId": "app_sf3kdjf3"}},
+		"freeform_tags":             Representation{repType: Optional, create: map[string]string{"Department": "Finance"}, update: map[string]string{"Department": "Accounting"}},
+		"scim_client_reset_trigger": Representation{repType: Optional, create: `reset1`, update: `reset2`},
 	}
 
 	IdentityProviderResourceDependencies = DefinedTagsDependencies + IdentityProviderPropertyVariables
@@ -73,7 +75,7 @@ func TestIdentityIdentityProviderResource_basic(t *testing.T) {
 	resourceName := "oci_identity_identity_provider.test_identity_provider"
 	datasourceName := "data.oci_identity_identity_providers.test_identity_providers"
 
-	var resId, resId2 string
+	var resId, resId2, scimClientSecret string
 
 	metadataContents, err := ioutil.ReadFile(metadataFile)
 	if err != nil {
@@ -133,11 +135,18 @@ func TestIdentityIdentityProviderResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "product_type", "ADFS"),
 					resource.TestCheckResourceAttr(resourceName, "protocol", "SAML2"),
 					resource.TestCheckResourceAttrSet(resourceName, "redirect_url"),
+					resource.TestCheckResourceAttrSet(resourceName, "scim_client_id"),
+					resource.TestCheckResourceAttr(resourceName, "scim_client_reset_trigger", "reset1"),
+					resource.TestCheckResourceAttrSet(resourceName, "scim_client_secret"),
 					resource.TestCheckResourceAttrSet(resourceName, "state"),
 					resource.TestCheckResourceAttrSet(resourceName, "time_created"),
 
 					func(s *terraform.State) (err error) {
 						resId, err = fromInstanceState(s, resourceName, "id")
+						if err != nil {
+							return err
+						}
+						scimClientSecret, err = fromInstanceState(s, resourceName, "scim_client_secret")
 						return err
 					},
 				),
@@ -160,6 +169,7 @@ func TestIdentityIdentityProviderResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "product_type", "ADFS"),
 					resource.TestCheckResourceAttr(resourceName, "protocol", "SAML2"),
 					resource.TestCheckResourceAttrSet(resourceName, "redirect_url"),
+					resource.TestCheckResourceAttr(resourceName, "scim_client_reset_trigger", "reset2"),
 					resource.TestCheckResourceAttrSet(resourceName, "state"),
 					resource.TestCheckResourceAttrSet(resourceName, "time_created"),
 
@@ -168,7 +178,17 @@ func TestIdentityIdentityProviderResource_basic(t *testing.T) {
 						if resId != resId2 {
 							return fmt.Errorf("Resource recreated when it was supposed to be updated.")
 						}
-						return err
+						if err != nil {
+							return err
+						}
+						scimClientSecret2, err := fromInstanceState(s, resourceName, "scim_client_secret")
+						if err != nil {
+							return err
+						}
+						if scimClientSecret == scimClientSecret2 {
+							return fmt.Errorf("the SCIM client secret was not reset")
+						}
+						return nil
 					},
 				),
 			},
@@ -205,6 +225,9 @@ func TestIdentityIdentityProviderResource_basic(t *testing.T) {
 				ImportStateVerifyIgnore: []string{
 					"metadata",
 					"metadata_url",
+					"scim_client_id",
+					"scim_client_reset_trigger",
+					"scim_client_secret",
 				},
 				ResourceName: resourceName,
 			},
@@ -251,3 +274,32 @@ func testAccCheckIdentityIdentityProviderDestroy(s *terraform.State) error {
 
 	return nil
 }
+
+func TestUnitIdentityIdentityProviderResource_scimClientResetRequested(t *testing.T) {
+	type testCase struct {
+		old      string
+		new      string
+		expected bool
+	}
+	testCases := []testCase{
+		{"reset1", "reset2", true},
+		{"", "reset1", true},
+		{"reset1", "reset1", false},
+		{"reset1", "", false},
+	}
+
+	for _, test := range testCases {
+		state := &terraform.InstanceState{ID: "ocid1.saml2idp.oc1..aaaa", Attributes: map[string]string{"scim_client_reset_trigger": test.old}}
+		diff := &terraform.InstanceDiff{Attributes: map[string]*terraform.ResourceAttrDiff{
+			"scim_client_reset_trigger": {Old: test.old, New: test.new, NewRemoved: test.new == ""},
+		}}
+		d, err := schema.InternalMap(IdentityIdentityProviderResource().Schema).Data(state, diff)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if actual := scimClientResetRequested(d); actual != test.expected {
+			t.Errorf("Expected a reset to be requested to be %v when the trigger changes from %q to %q, got %v", test.expected, test.old, test.new, actual)
+		}
+	}
+}
@@ -4,7 +4,11 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"net/url"
+	"regexp"
 	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
 
@@ -13,6 +17,9 @@ import (
 
 func IdentityIdpGroupMappingResource() *schema.Resource {
 	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: importIdentityIdpGroupMapping,
+		},
 		Timeouts: DefaultTimeout,
 		Create:   createIdentityIdpGroupMapping,
 		Read:     readIdentityIdpGroupMapping,
@@ -254,3 +261,34 @@ func (s *IdentityIdpGroupMappingResourceCrud) SetData() error {
 
 	return nil
 }
+
+func importIdentityIdpGroupMapping(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	identityProviderId, mappingId, err := parseIdpGroupMappingCompositeId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(mappingId)
+	err = d.Set("identity_provider_id", identityProviderId)
+	return []*schema.ResourceData{d}, err
+}
+
+func getIdpGroupMappingCompositeId(identityProviderId string, mappingId string) string {
+	identityProviderId = url.PathEscape(identityProviderId)
+	mappingId = url.PathEscape(mappingId)
+	compositeId := "identityProviders/" + identityProviderId + "/groupMappings/" + mappingId
+	return compositeId
+}
+
+func parseIdpGroupMappingCompositeId(compositeId string) (identityProviderId string, mappingId string, err error) {
+	parts := strings.Split(compositeId, "/")
+	match, _ := regexp.MatchString("^identityProviders/[^/]+/groupMappings/[^/]+$", compositeId)
+	if !match || len(parts) != 4 {
+		err = fmt.Errorf("illegal compositeId %s encountered, expected format: identityProviders/{identityProviderId}/groupMappings/{mappingId}", compositeId)
+		return
+	}
+	identityProviderId, _ = url.PathUnescape(parts[1])
+	mappingId, _ = url.PathUnescape(parts[3])
+
+	return
+}
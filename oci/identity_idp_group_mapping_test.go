@@ -120,10 +120,30 @@ func TestIdentityIdpGroupMappingResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(datasourceName, "idp_group_mappings.0.time_created"),
 				),
 			},
+			// verify resource import
+			{
+				Config:                  config,
+				ImportStateIdFunc:       getIdentityIdpGroupMappingImportId(resourceName),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{},
+				ResourceName:            resourceName,
+			},
 		},
 	})
 }
 
+func getIdentityIdpGroupMappingImportId(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return getIdpGroupMappingCompositeId(rs.Primary.Attributes["identity_provider_id"], rs.Primary.ID), nil
+	}
+}
+
 func testAccCheckIdentityIdpGroupMappingDestroy(s *terraform.State) error {
 	noResourceFound := true
 	client := testAccProvider.Meta().(*OracleClients).identityClient
@@ -0,0 +1,354 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	oci_identity "github.com/oracle/oci-go-sdk/identity"
+)
+
+func IdentityMfaTotpDeviceResource() *schema.Resource {
+	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: importIdentityMfaTotpDevice,
+		},
+		Timeouts:      DefaultTimeout,
+		Create:        createIdentityMfaTotpDevice,
+		Read:          readIdentityMfaTotpDevice,
+		Update:        updateIdentityMfaTotpDevice,
+		Delete:        deleteIdentityMfaTotpDevice,
+		CustomizeDiff: validateTotpTokenOnCreate,
+		Schema: map[string]*schema.Schema{
+			// Required
+			"user_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// Optional
+			"totp_token": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+				// The token is only used once to activate the device, it expires shortly after
+				DiffSuppressFunc: func(k string, old string, new string, d *schema.ResourceData) bool {
+					return d.Get("is_activated").(bool)
+				},
+			},
+
+			// Computed
+			"inactive_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_activated": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"seed": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"time_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"time_expires": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func createIdentityMfaTotpDevice(d *schema.ResourceData, m interface{}) error {
+	sync := &IdentityMfaTotpDeviceResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).identityClient
+
+	return CreateResource(d, sync)
+}
+
+func readIdentityMfaTotpDevice(d *schema.ResourceData, m interface{}) error {
+	sync := &IdentityMfaTotpDeviceResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).identityClient
+
+	return ReadResource(sync)
+}
+
+func updateIdentityMfaTotpDevice(d *schema.ResourceData, m interface{}) error {
+	sync := &IdentityMfaTotpDeviceResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).identityClient
+
+	return UpdateResource(d, sync)
+}
+
+func deleteIdentityMfaTotpDevice(d *schema.ResourceData, m interface{}) error {
+	sync := &IdentityMfaTotpDeviceResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).identityClient
+	sync.DisableNotFoundRetries = true
+
+	return DeleteResource(d, sync)
+}
+
+type IdentityMfaTotpDeviceResourceCrud struct {
+	BaseCrud
+	Client                 *oci_identity.IdentityClient
+	Res                    *oci_identity.MfaTotpDevice
+	DisableNotFoundRetries bool
+}
+
+func (s *IdentityMfaTotpDeviceResourceCrud) ID() string {
+	return *s.Res.Id
+}
+
+func (s *IdentityMfaTotpDeviceResourceCrud) CreatedPending() []string {
+	return []string{
+		string(oci_identity.MfaTotpDeviceLifecycleStateCreating),
+	}
+}
+
+func (s *IdentityMfaTotpDeviceResourceCrud) CreatedTarget() []string {
+	return []string{
+		string(oci_identity.MfaTotpDeviceLifecycleStateActive),
+	}
+}
+
+func (s *IdentityMfaTotpDeviceResourceCrud) DeletedPending() []string {
+	return []string{
+		string(oci_identity.MfaTotpDeviceLifecycleStateDeleting),
+	}
+}
+
+func (s *IdentityMfaTotpDeviceResourceCrud) DeletedTarget() []string {
+	return []string{
+		string(oci_identity.MfaTotpDeviceLifecycleStateDeleted),
+	}
+}
+
+func (s *IdentityMfaTotpDeviceResourceCrud) Create() error {
+	request := oci_identity.CreateMfaTotpDeviceRequest{}
+
+	if userId, ok := s.D.GetOkExists("user_id"); ok {
+		tmp := userId.(string)
+		request.UserId = &tmp
+	}
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
+
+	response, err := s.Client.CreateMfaTotpDevice(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.MfaTotpDevice
+
+	// The seed is only returned once, a new seed is generated if it is missing from the response
+	if s.Res.Seed == nil || *s.Res.Seed == "" {
+		if err := s.generateTotpSeed(); err != nil {
+			return err
+		}
+	}
+
+	// Get does not return the seed, it is set before waiting for the device to become active
+	if s.Res.Seed != nil {
+		s.D.Set("seed", *s.Res.Seed)
+	}
+
+	return nil
+}
+
+func (s *IdentityMfaTotpDeviceResourceCrud) generateTotpSeed() error {
+	request := oci_identity.GenerateTotpSeedRequest{}
+	request.UserId = s.Res.UserId
+	request.MfaTotpDeviceId = s.Res.Id
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
+
+	response, err := s.Client.GenerateTotpSeed(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.MfaTotpDevice
+	return nil
+}
+
+func (s *IdentityMfaTotpDeviceResourceCrud) activate(totpToken string) error {
+	request := oci_identity.ActivateMfaTotpDeviceRequest{}
+	request.UserId = s.Res.UserId
+	request.MfaTotpDeviceId = s.Res.Id
+	request.TotpToken = &totpToken
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
+
+	response, err := s.Client.ActivateMfaTotpDevice(context.Background(), request)
+	if err != nil {
+		return fmt.Errorf("failed to activate the MFA TOTP device %s: %s", *s.Res.Id, err)
+	}
+
+	seed := s.Res.Seed
+	s.Res = fromMfaTotpDeviceSummary(response.MfaTotpDeviceSummary)
+	s.Res.Seed = seed
+	return nil
+}
+
+func (s *IdentityMfaTotpDeviceResourceCrud) Get() error {
+	request := oci_identity.GetMfaTotpDeviceRequest{}
+
+	tmp := s.D.Id()
+	request.MfaTotpDeviceId = &tmp
+
+	if userId, ok := s.D.GetOkExists("user_id"); ok {
+		tmp := userId.(string)
+		request.UserId = &tmp
+	}
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
+
+	response, err := s.Client.GetMfaTotpDevice(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = fromMfaTotpDeviceSummary(response.MfaTotpDeviceSummary)
+	return nil
+}
+
+func (s *IdentityMfaTotpDeviceResourceCrud) Update() error {
+	if totpToken, ok := s.D.GetOkExists("totp_token"); ok && s.D.HasChange("totp_token") {
+		if err := s.Get(); err != nil {
+			return err
+		}
+
+		if s.Res.IsActivated == nil || !*s.Res.IsActivated {
+			if err := s.activate(totpToken.(string)); err != nil {
+				// Keep the previous token so that setting the same token again retries the activation
+				oldTotpToken, _ := s.D.GetChange("totp_token")
+				s.D.Set("totp_token", oldTotpToken)
+				return err
+			}
+		}
+	}
+
+	return s.Get()
+}
+
+func (s *IdentityMfaTotpDeviceResourceCrud) Delete() error {
+	request := oci_identity.DeleteMfaTotpDeviceRequest{}
+
+	tmp := s.D.Id()
+	request.MfaTotpDeviceId = &tmp
+
+	if userId, ok := s.D.GetOkExists("user_id"); ok {
+		tmp := userId.(string)
+		request.UserId = &tmp
+	}
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
+
+	_, err := s.Client.DeleteMfaTotpDevice(context.Background(), request)
+	return err
+}
+
+func (s *IdentityMfaTotpDeviceResourceCrud) SetData() error {
+	if s.Res.InactiveStatus != nil {
+		s.D.Set("inactive_state", strconv.FormatInt(*s.Res.InactiveStatus, 10))
+	}
+
+	if s.Res.IsActivated != nil {
+		s.D.Set("is_activated", *s.Res.IsActivated)
+	}
+
+	// The seed is only returned when the device is created, keep the one from the state
+	if s.Res.Seed != nil {
+		s.D.Set("seed", *s.Res.Seed)
+	}
+
+	s.D.Set("state", s.Res.LifecycleState)
+
+	if s.Res.TimeCreated != nil {
+		s.D.Set("time_created", s.Res.TimeCreated.String())
+	}
+
+	if s.Res.TimeExpires != nil {
+		s.D.Set("time_expires", s.Res.TimeExpires.String())
+	}
+
+	if s.Res.UserId != nil {
+		s.D.Set("user_id", *s.Res.UserId)
+	}
+
+	return nil
+}
+
+func fromMfaTotpDeviceSummary(summary oci_identity.MfaTotpDeviceSummary) *oci_identity.MfaTotpDevice {
+	s := &oci_identity.MfaTotpDevice{}
+	s.Id = summary.Id
+	s.UserId = summary.UserId
+	s.TimeCreated = summary.TimeCreated
+	s.TimeExpires = summary.TimeExpires
+	s.LifecycleState = oci_identity.MfaTotpDeviceLifecycleStateEnum(summary.LifecycleState)
+	s.InactiveStatus = summary.InactiveStatus
+	s.IsActivated = summary.IsActivated
+	return s
+}
+
+func importIdentityMfaTotpDevice(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	userId, mfaTotpDeviceId, err := parseMfaTotpDeviceCompositeId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(mfaTotpDeviceId)
+	err = d.Set("user_id", userId)
+	return []*schema.ResourceData{d}, err
+}
+
+func getMfaTotpDeviceCompositeId(userId string, mfaTotpDeviceId string) string {
+	userId = url.PathEscape(userId)
+	mfaTotpDeviceId = url.PathEscape(mfaTotpDeviceId)
+	compositeId := "users/" + userId + "/mfaTotpDevices/" + mfaTotpDeviceId
+	return compositeId
+}
+
+func parseMfaTotpDeviceCompositeId(compositeId string) (userId string, mfaTotpDeviceId string, err error) {
+	parts := strings.Split(compositeId, "/")
+	match, _ := regexp.MatchString("^users/[^/]+/mfaTotpDevices/[^/]+$", compositeId)
+	if !match || len(parts) != 4 {
+		err = fmt.Errorf("illegal compositeId %s encountered, expected format: users/{userId}/mfaTotpDevices/{mfaTotpDeviceId}", compositeId)
+		return
+	}
+	userId, _ = url.PathUnescape(parts[1])
+	mfaTotpDeviceId, _ = url.PathUnescape(parts[3])
+
+	return
+}
+
+// The TOTP code is generated from the seed, which is only known once the device is created
+func validateTotpTokenOnCreate(d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() != "" {
+		return nil
+	}
+	if _, ok := d.GetOk("totp_token"); ok {
+		return fmt.Errorf("totp_token can't be set when the device is created, set it to a code generated from the exported seed once the device exists to activate it")
+	}
+	return nil
+}
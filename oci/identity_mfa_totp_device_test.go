@@ -0,0 +1,175 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/oracle/oci-go-sdk/common"
+	oci_identity "github.com/oracle/oci-go-sdk/identity"
+
+	"github.com/terraform-providers/terraform-provider-oci/httpreplay"
+)
+
+var (
+	mfaTotpDeviceDataSourceRepresentation = map[string]interface{}{
+		"user_id": Representation{repType: Required, create: `${oci_identity_user.test_user.id}`},
+		"filter":  RepresentationGroup{Required, mfaTotpDeviceDataSourceFilterRepresentation}}
+	mfaTotpDeviceDataSourceFilterRepresentation = map[string]interface{}{
+		"name":   Representation{repType: Required, create: `id`},
+		"values": Representation{repType: Required, create: []string{`${oci_identity_mfa_totp_device.test_mfa_totp_device.id}`}},
+	}
+
+	mfaTotpDeviceRepresentation = map[string]interface{}{
+		"user_id": Representation{repType: Required, create: `${oci_identity_user.test_user.id}`},
+	}
+
+	MfaTotpDeviceResourceDependencies = UserRequiredOnlyResource
+)
+
+func TestIdentityMfaTotpDeviceResource_basic(t *testing.T) {
+	httpreplay.SetScenario("TestIdentityMfaTotpDeviceResource_basic")
+	defer httpreplay.SaveScenario()
+
+	provider := testAccProvider
+	config := testProviderConfig()
+
+	compartmentId := getEnvSettingWithBlankDefault("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	resourceName := "oci_identity_mfa_totp_device.test_mfa_totp_device"
+	datasourceName := "data.oci_identity_mfa_totp_devices.test_mfa_totp_devices"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Providers: map[string]terraform.ResourceProvider{
+			"oci": provider,
+		},
+		CheckDestroy: testAccCheckIdentityMfaTotpDeviceDestroy,
+		Steps: []resource.TestStep{
+			// verify create
+			{
+				Config: config + compartmentIdVariableStr + MfaTotpDeviceResourceDependencies +
+					generateResourceFromRepresentationMap("oci_identity_mfa_totp_device", "test_mfa_totp_device", Required, Create, mfaTotpDeviceRepresentation),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "user_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "is_activated", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "seed"),
+					resource.TestCheckResourceAttr(resourceName, "state", string(oci_identity.MfaTotpDeviceLifecycleStateActive)),
+					resource.TestCheckResourceAttrSet(resourceName, "time_created"),
+				),
+			},
+			// verify datasource
+			{
+				Config: config +
+					generateDataSourceFromRepresentationMap("oci_identity_mfa_totp_devices", "test_mfa_totp_devices", Required, Create, mfaTotpDeviceDataSourceRepresentation) +
+					compartmentIdVariableStr + MfaTotpDeviceResourceDependencies +
+					generateResourceFromRepresentationMap("oci_identity_mfa_totp_device", "test_mfa_totp_device", Required, Create, mfaTotpDeviceRepresentation),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(datasourceName, "user_id"),
+
+					resource.TestCheckResourceAttr(datasourceName, "mfa_totp_devices.#", "1"),
+					resource.TestCheckResourceAttrSet(datasourceName, "mfa_totp_devices.0.id"),
+					resource.TestCheckResourceAttr(datasourceName, "mfa_totp_devices.0.is_activated", "false"),
+					resource.TestCheckNoResourceAttr(datasourceName, "mfa_totp_devices.0.seed"),
+					resource.TestCheckResourceAttrSet(datasourceName, "mfa_totp_devices.0.state"),
+					resource.TestCheckResourceAttrSet(datasourceName, "mfa_totp_devices.0.time_created"),
+					resource.TestCheckResourceAttrSet(datasourceName, "mfa_totp_devices.0.user_id"),
+				),
+			},
+			// verify resource import
+			{
+				Config:            config,
+				ImportStateIdFunc: getIdentityMfaTotpDeviceImportId(resourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"seed",
+					"totp_token",
+				},
+				ResourceName: resourceName,
+			},
+		},
+	})
+}
+
+func getIdentityMfaTotpDeviceImportId(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return getMfaTotpDeviceCompositeId(rs.Primary.Attributes["user_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccCheckIdentityMfaTotpDeviceDestroy(s *terraform.State) error {
+	noResourceFound := true
+	client := testAccProvider.Meta().(*OracleClients).identityClient
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type == "oci_identity_mfa_totp_device" {
+			noResourceFound = false
+			request := oci_identity.GetMfaTotpDeviceRequest{}
+
+			tmp := rs.Primary.ID
+			request.MfaTotpDeviceId = &tmp
+
+			if value, ok := rs.Primary.Attributes["user_id"]; ok {
+				request.UserId = &value
+			}
+
+			request.RequestMetadata.RetryPolicy = getRetryPolicy(true, "identity")
+			response, err := client.GetMfaTotpDevice(context.Background(), request)
+
+			if err == nil {
+				deletedLifecycleStates := map[string]bool{
+					string(oci_identity.MfaTotpDeviceLifecycleStateDeleted): true,
+				}
+				if _, ok := deletedLifecycleStates[string(response.LifecycleState)]; !ok {
+					//resource lifecycle state is not in expected deleted lifecycle states.
+					return fmt.Errorf("resource lifecycle state: %s is not in expected deleted lifecycle states", response.LifecycleState)
+				}
+				//resource lifecycle state is in expected deleted lifecycle states. continue with next one.
+				continue
+			}
+
+			//Verify that exception is for '404 not found'.
+			if failure, isServiceError := common.IsServiceError(err); !isServiceError || failure.GetHTTPStatusCode() != 404 {
+				return err
+			}
+		}
+	}
+	if noResourceFound {
+		return fmt.Errorf("at least one resource was expected from the state file, but could not be found")
+	}
+
+	return nil
+}
+
+func TestUnitIdentityMfaTotpDeviceResource_totpTokenOnCreate(t *testing.T) {
+	raw, err := config.NewRawConfig(map[string]interface{}{"user_id": "ocid1.user.oc1..aaaa", "totp_token": "123456"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	mfaTotpDevice := IdentityMfaTotpDeviceResource()
+
+	if _, err := mfaTotpDevice.Diff(nil, terraform.NewResourceConfig(raw), nil); err == nil {
+		t.Errorf("Expected an error when totp_token is set on create")
+	}
+
+	existing := &terraform.InstanceState{ID: "ocid1.credential.oc1..aaaa", Attributes: map[string]string{"user_id": "ocid1.user.oc1..aaaa", "is_activated": "false"}}
+	diff, err := mfaTotpDevice.Diff(existing, terraform.NewResourceConfig(raw), nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if attr := diff.Attributes["totp_token"]; attr == nil || attr.New != "123456" {
+		t.Errorf("Expected the token to activate the existing device, got %v", attr)
+	}
+}
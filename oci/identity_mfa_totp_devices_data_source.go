@@ -0,0 +1,127 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	oci_identity "github.com/oracle/oci-go-sdk/identity"
+)
+
+func IdentityMfaTotpDevicesDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: readIdentityMfaTotpDevices,
+		Schema: map[string]*schema.Schema{
+			"filter": dataSourceFiltersSchema(),
+			"user_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"mfa_totp_devices": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     GetDataSourceItemSchema(IdentityMfaTotpDeviceResource()),
+			},
+		},
+	}
+}
+
+func readIdentityMfaTotpDevices(d *schema.ResourceData, m interface{}) error {
+	sync := &IdentityMfaTotpDevicesDataSourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).identityClient
+
+	return ReadResource(sync)
+}
+
+type IdentityMfaTotpDevicesDataSourceCrud struct {
+	D      *schema.ResourceData
+	Client *oci_identity.IdentityClient
+	Res    *oci_identity.ListMfaTotpDevicesResponse
+}
+
+func (s *IdentityMfaTotpDevicesDataSourceCrud) VoidState() {
+	s.D.SetId("")
+}
+
+func (s *IdentityMfaTotpDevicesDataSourceCrud) Get() error {
+	request := oci_identity.ListMfaTotpDevicesRequest{}
+
+	if userId, ok := s.D.GetOkExists("user_id"); ok {
+		tmp := userId.(string)
+		request.UserId = &tmp
+	}
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "identity")
+
+	response, err := s.Client.ListMfaTotpDevices(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response
+	request.Page = s.Res.OpcNextPage
+
+	for request.Page != nil {
+		listResponse, err := s.Client.ListMfaTotpDevices(context.Background(), request)
+		if err != nil {
+			return err
+		}
+
+		s.Res.Items = append(s.Res.Items, listResponse.Items...)
+		request.Page = listResponse.OpcNextPage
+	}
+
+	return nil
+}
+
+func (s *IdentityMfaTotpDevicesDataSourceCrud) SetData() error {
+	if s.Res == nil {
+		return nil
+	}
+
+	s.D.SetId(GenerateDataSourceID())
+	resources := []map[string]interface{}{}
+
+	for _, r := range s.Res.Items {
+		mfaTotpDevice := map[string]interface{}{
+			"user_id": *r.UserId,
+		}
+
+		if r.Id != nil {
+			mfaTotpDevice["id"] = *r.Id
+		}
+
+		if r.InactiveStatus != nil {
+			mfaTotpDevice["inactive_state"] = strconv.FormatInt(*r.InactiveStatus, 10)
+		}
+
+		if r.IsActivated != nil {
+			mfaTotpDevice["is_activated"] = *r.IsActivated
+		}
+
+		mfaTotpDevice["state"] = r.LifecycleState
+
+		if r.TimeCreated != nil {
+			mfaTotpDevice["time_created"] = r.TimeCreated.String()
+		}
+
+		if r.TimeExpires != nil {
+			mfaTotpDevice["time_expires"] = r.TimeExpires.String()
+		}
+
+		resources = append(resources, mfaTotpDevice)
+	}
+
+	if f, fOk := s.D.GetOkExists("filter"); fOk {
+		resources = ApplyFilters(f.(*schema.Set), resources, IdentityMfaTotpDevicesDataSource().Schema["mfa_totp_devices"].Elem.(*schema.Resource).Schema)
+	}
+
+	if err := s.D.Set("mfa_totp_devices", resources); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,232 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	oci_identity "github.com/oracle/oci-go-sdk/identity"
+)
+
+func IdentityRegionSubscriptionResource() *schema.Resource {
+	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: importIdentityRegionSubscription,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: &OneHour,
+		},
+		Create: createIdentityRegionSubscription,
+		Read:   readIdentityRegionSubscription,
+		Delete: deleteIdentityRegionSubscription,
+		Schema: map[string]*schema.Schema{
+			// Required
+			"region_key": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: EqualIgnoreCaseSuppressDiff,
+			},
+			"tenancy_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// Optional
+
+			// Computed
+			"is_home_region": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"region_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		// Replacing a subscription would destroy it, fail at plan time instead of when the old one is destroyed
+		CustomizeDiff: func(d *schema.ResourceDiff, m interface{}) error {
+			if d.Id() == "" {
+				return nil
+			}
+
+			for _, key := range []string{"region_key", "tenancy_id"} {
+				if d.HasChange(key) {
+					old, new := d.GetChange(key)
+					return fmt.Errorf("region subscriptions cannot be removed, %s cannot be changed from %v to %v. Add a new oci_identity_region_subscription resource instead", key, old, new)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func createIdentityRegionSubscription(d *schema.ResourceData, m interface{}) error {
+	sync := &IdentityRegionSubscriptionResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).identityClient
+
+	return CreateResource(d, sync)
+}
+
+func readIdentityRegionSubscription(d *schema.ResourceData, m interface{}) error {
+	sync := &IdentityRegionSubscriptionResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).identityClient
+
+	return ReadResource(sync)
+}
+
+// Region subscriptions cannot be removed, refuse to destroy the resource rather than silently dropping it from the state
+func deleteIdentityRegionSubscription(d *schema.ResourceData, m interface{}) error {
+	return fmt.Errorf("the subscription of tenancy %s to region %s cannot be removed. Remove the resource from the state with `terraform state rm` instead, "+
+		"and use `lifecycle { prevent_destroy = true }` to be warned at plan time", d.Get("tenancy_id"), d.Get("region_key"))
+}
+
+type IdentityRegionSubscriptionResourceCrud struct {
+	BaseCrud
+	Client                 *oci_identity.IdentityClient
+	Res                    *oci_identity.RegionSubscription
+	DisableNotFoundRetries bool
+}
+
+func (s *IdentityRegionSubscriptionResourceCrud) ID() string {
+	return getRegionSubscriptionCompositeId(s.D.Get("tenancy_id").(string), *s.Res.RegionKey)
+}
+
+func (s *IdentityRegionSubscriptionResourceCrud) CreatedPending() []string {
+	return []string{
+		string(oci_identity.RegionSubscriptionStatusInProgress),
+	}
+}
+
+func (s *IdentityRegionSubscriptionResourceCrud) CreatedTarget() []string {
+	return []string{
+		string(oci_identity.RegionSubscriptionStatusReady),
+	}
+}
+
+// The state of a subscription is its status
+func (s *IdentityRegionSubscriptionResourceCrud) setState(sync StatefulResource) error {
+	return s.D.Set("state", string(s.Res.Status))
+}
+
+func (s *IdentityRegionSubscriptionResourceCrud) Create() error {
+	// The tenancy may already be subscribed to the region, e.g. when it is the home region
+	if err := s.Get(); err == nil {
+		return nil
+	} else if !strings.Contains(err.Error(), "not found") {
+		return err
+	}
+
+	request := oci_identity.CreateRegionSubscriptionRequest{}
+
+	if regionKey, ok := s.D.GetOkExists("region_key"); ok {
+		tmp := strings.ToUpper(regionKey.(string))
+		request.RegionKey = &tmp
+	}
+
+	if tenancyId, ok := s.D.GetOkExists("tenancy_id"); ok {
+		tmp := tenancyId.(string)
+		request.TenancyId = &tmp
+	}
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
+
+	response, err := s.Client.CreateRegionSubscription(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.RegionSubscription
+	return nil
+}
+
+func (s *IdentityRegionSubscriptionResourceCrud) Get() error {
+	request := oci_identity.ListRegionSubscriptionsRequest{}
+
+	if tenancyId, ok := s.D.GetOkExists("tenancy_id"); ok {
+		tmp := tenancyId.(string)
+		request.TenancyId = &tmp
+	}
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
+
+	response, err := s.Client.ListRegionSubscriptions(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	regionKey := s.D.Get("region_key").(string)
+	for _, item := range response.Items {
+		if item.RegionKey != nil && strings.EqualFold(*item.RegionKey, regionKey) {
+			res := item
+			s.Res = &res
+			return nil
+		}
+	}
+	return fmt.Errorf("RegionSubscription of tenancy %s to region %s not found", *request.TenancyId, regionKey)
+}
+
+func (s *IdentityRegionSubscriptionResourceCrud) SetData() error {
+	if s.Res.IsHomeRegion != nil {
+		s.D.Set("is_home_region", *s.Res.IsHomeRegion)
+	}
+
+	if s.Res.RegionKey != nil {
+		s.D.Set("region_key", *s.Res.RegionKey)
+	}
+
+	if s.Res.RegionName != nil {
+		s.D.Set("region_name", *s.Res.RegionName)
+	}
+
+	s.D.Set("state", s.Res.Status)
+
+	return nil
+}
+
+func importIdentityRegionSubscription(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	tenancyId, regionKey, err := parseRegionSubscriptionCompositeId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("tenancy_id", tenancyId); err != nil {
+		return nil, err
+	}
+	err = d.Set("region_key", regionKey)
+	return []*schema.ResourceData{d}, err
+}
+
+func getRegionSubscriptionCompositeId(tenancyId string, regionKey string) string {
+	tenancyId = url.PathEscape(tenancyId)
+	regionKey = url.PathEscape(regionKey)
+	compositeId := "tenancies/" + tenancyId + "/regionSubscriptions/" + regionKey
+	return compositeId
+}
+
+func parseRegionSubscriptionCompositeId(compositeId string) (tenancyId string, regionKey string, err error) {
+	parts := strings.Split(compositeId, "/")
+	match, _ := regexp.MatchString("^tenancies/[^/]+/regionSubscriptions/[^/]+$", compositeId)
+	if !match || len(parts) != 4 {
+		err = fmt.Errorf("illegal compositeId %s encountered, expected format: tenancies/{tenancyId}/regionSubscriptions/{regionKey}", compositeId)
+		return
+	}
+	tenancyId, _ = url.PathUnescape(parts[1])
+	regionKey, _ = url.PathUnescape(parts[3])
+
+	return
+}
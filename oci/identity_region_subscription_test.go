@@ -3,9 +3,12 @@
 package provider
 
 import (
+	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 
 	"github.com/terraform-providers/terraform-provider-oci/httpreplay"
@@ -21,6 +24,18 @@ var (
 		"name":   Representation{repType: Required, create: `is_home_region`},
 		"values": Representation{repType: Required, create: []string{`true`}},
 	}
+
+	// The tenancy is always subscribed to its home region, so the test does not subscribe to a new region
+	regionSubscriptionRepresentation = map[string]interface{}{
+		"region_key": Representation{repType: Required, create: `${data.oci_identity_tenancy.test_tenancy.home_region_key}`, update: `XYZ`},
+		"tenancy_id": Representation{repType: Required, create: `${var.tenancy_ocid}`},
+	}
+
+	RegionSubscriptionResourceDependencies = `
+data "oci_identity_tenancy" "test_tenancy" {
+	tenancy_id = "${var.tenancy_ocid}"
+}
+`
 )
 
 func TestIdentityRegionSubscriptionResource_basic(t *testing.T) {
@@ -54,3 +69,80 @@ func TestIdentityRegionSubscriptionResource_basic(t *testing.T) {
 		},
 	})
 }
+
+func TestIdentityRegionSubscriptionResource_homeRegion(t *testing.T) {
+	httpreplay.SetScenario("TestIdentityRegionSubscriptionResource_homeRegion")
+	defer httpreplay.SaveScenario()
+
+	provider := testAccProvider
+	config := testProviderConfig()
+
+	resourceName := "oci_identity_region_subscription.test_region_subscription"
+
+	// Subscriptions cannot be removed, the resource is only dropped from the state at the end of the test
+	regionSubscriptionResource := provider.ResourcesMap["oci_identity_region_subscription"]
+	deleteRegionSubscription := regionSubscriptionResource.Delete
+	defer func() { regionSubscriptionResource.Delete = deleteRegionSubscription }()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Providers: map[string]terraform.ResourceProvider{
+			"oci": provider,
+		},
+		Steps: []resource.TestStep{
+			// verify create
+			{
+				Config: config + RegionSubscriptionResourceDependencies +
+					generateResourceFromRepresentationMap("oci_identity_region_subscription", "test_region_subscription", Required, Create, regionSubscriptionRepresentation),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					TestCheckResourceAttributesEqual(resourceName, "region_key", "data.oci_identity_tenancy.test_tenancy", "home_region_key"),
+					resource.TestCheckResourceAttrSet(resourceName, "tenancy_id"),
+					resource.TestCheckResourceAttr(resourceName, "is_home_region", "true"),
+					resource.TestCheckResourceAttr(resourceName, "region_name", getRequiredEnvSetting("region")),
+					resource.TestCheckResourceAttr(resourceName, "state", "READY"),
+				),
+			},
+			// verify the subscription cannot be replaced
+			{
+				Config: config + RegionSubscriptionResourceDependencies +
+					generateResourceFromRepresentationMap("oci_identity_region_subscription", "test_region_subscription", Required, Update, regionSubscriptionRepresentation),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("region_key cannot be changed"),
+			},
+			// verify the subscription cannot be destroyed
+			{
+				Config: config + RegionSubscriptionResourceDependencies +
+					generateResourceFromRepresentationMap("oci_identity_region_subscription", "test_region_subscription", Required, Create, regionSubscriptionRepresentation),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("cannot be removed"),
+			},
+			// verify resource import
+			{
+				Config:            config,
+				ImportStateIdFunc: getIdentityRegionSubscriptionImportId(resourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+				ResourceName:      resourceName,
+			},
+			// drop the subscription from the state when the test is over
+			{
+				PreConfig: func() {
+					regionSubscriptionResource.Delete = func(d *schema.ResourceData, m interface{}) error { return nil }
+				},
+				Config: config + RegionSubscriptionResourceDependencies +
+					generateResourceFromRepresentationMap("oci_identity_region_subscription", "test_region_subscription", Required, Create, regionSubscriptionRepresentation),
+			},
+		},
+	})
+}
+
+func getIdentityRegionSubscriptionImportId(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return getRegionSubscriptionCompositeId(rs.Primary.Attributes["tenancy_id"], rs.Primary.Attributes["region_key"]), nil
+	}
+}
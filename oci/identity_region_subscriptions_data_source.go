@@ -21,37 +21,7 @@ func IdentityRegionSubscriptionsDataSource() *schema.Resource {
 			"region_subscriptions": {
 				Type:     schema.TypeList,
 				Computed: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						// Required
-						"region_key": {
-							Type:     schema.TypeString,
-							Required: true,
-							ForceNew: true,
-						},
-						"tenancy_id": {
-							Type:     schema.TypeString,
-							Required: true,
-							ForceNew: true,
-						},
-
-						// Optional
-
-						// Computed
-						"is_home_region": {
-							Type:     schema.TypeBool,
-							Computed: true,
-						},
-						"region_name": {
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-						"state": {
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-					},
-				},
+				Elem:     GetDataSourceItemSchema(IdentityRegionSubscriptionResource()),
 			},
 		},
 	}
@@ -5,7 +5,11 @@ package provider
 import (
 	"context"
 	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
 	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
 
@@ -14,6 +18,9 @@ import (
 
 func IdentitySmtpCredentialResource() *schema.Resource {
 	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: importIdentitySmtpCredential,
+		},
 		Timeouts: DefaultTimeout,
 		Create:   createIdentitySmtpCredential,
 		Read:     readIdentitySmtpCredential,
@@ -272,3 +279,34 @@ func (s *IdentitySmtpCredentialResourceCrud) SetData() error {
 
 	return nil
 }
+
+func importIdentitySmtpCredential(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	userId, smtpCredentialId, err := parseSmtpCredentialCompositeId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(smtpCredentialId)
+	err = d.Set("user_id", userId)
+	return []*schema.ResourceData{d}, err
+}
+
+func getSmtpCredentialCompositeId(userId string, smtpCredentialId string) string {
+	userId = url.PathEscape(userId)
+	smtpCredentialId = url.PathEscape(smtpCredentialId)
+	compositeId := "users/" + userId + "/smtpCredentials/" + smtpCredentialId
+	return compositeId
+}
+
+func parseSmtpCredentialCompositeId(compositeId string) (userId string, smtpCredentialId string, err error) {
+	parts := strings.Split(compositeId, "/")
+	match, _ := regexp.MatchString("^users/[^/]+/smtpCredentials/[^/]+$", compositeId)
+	if !match || len(parts) != 4 {
+		err = fmt.Errorf("illegal compositeId %s encountered, expected format: users/{userId}/smtpCredentials/{smtpCredentialId}", compositeId)
+		return
+	}
+	userId, _ = url.PathUnescape(parts[1])
+	smtpCredentialId, _ = url.PathUnescape(parts[3])
+
+	return
+}
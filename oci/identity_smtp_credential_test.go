@@ -104,10 +104,32 @@ func TestIdentitySmtpCredentialResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(datasourceName, "smtp_credentials.0.username"),
 				),
 			},
+			// verify resource import
+			{
+				Config:            config,
+				ImportStateIdFunc: getIdentitySmtpCredentialImportId(resourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"password",
+				},
+				ResourceName: resourceName,
+			},
 		},
 	})
 }
 
+func getIdentitySmtpCredentialImportId(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return getSmtpCredentialCompositeId(rs.Primary.Attributes["user_id"], rs.Primary.ID), nil
+	}
+}
+
 func testAccCheckIdentitySmtpCredentialDestroy(s *terraform.State) error {
 	noResourceFound := true
 	client := testAccProvider.Meta().(*OracleClients).identityClient
@@ -5,7 +5,11 @@ package provider
 import (
 	"context"
 	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
 	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
 
@@ -14,6 +18,9 @@ import (
 
 func IdentitySwiftPasswordResource() *schema.Resource {
 	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: importIdentitySwiftPassword,
+		},
 		Timeouts: DefaultTimeout,
 		Create:   createIdentitySwiftPassword,
 		Read:     readIdentitySwiftPassword,
@@ -253,3 +260,34 @@ func (s *IdentitySwiftPasswordResourceCrud) SetData() error {
 
 	return nil
 }
+
+func importIdentitySwiftPassword(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	userId, swiftPasswordId, err := parseSwiftPasswordCompositeId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(swiftPasswordId)
+	err = d.Set("user_id", userId)
+	return []*schema.ResourceData{d}, err
+}
+
+func getSwiftPasswordCompositeId(userId string, swiftPasswordId string) string {
+	userId = url.PathEscape(userId)
+	swiftPasswordId = url.PathEscape(swiftPasswordId)
+	compositeId := "users/" + userId + "/swiftPasswords/" + swiftPasswordId
+	return compositeId
+}
+
+func parseSwiftPasswordCompositeId(compositeId string) (userId string, swiftPasswordId string, err error) {
+	parts := strings.Split(compositeId, "/")
+	match, _ := regexp.MatchString("^users/[^/]+/swiftPasswords/[^/]+$", compositeId)
+	if !match || len(parts) != 4 {
+		err = fmt.Errorf("illegal compositeId %s encountered, expected format: users/{userId}/swiftPasswords/{swiftPasswordId}", compositeId)
+		return
+	}
+	userId, _ = url.PathUnescape(parts[1])
+	swiftPasswordId, _ = url.PathUnescape(parts[3])
+
+	return
+}
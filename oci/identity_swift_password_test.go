@@ -103,10 +103,32 @@ func TestIdentitySwiftPasswordResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(datasourceName, "passwords.0.user_id"),
 				),
 			},
+			// verify resource import
+			{
+				Config:            config,
+				ImportStateIdFunc: getIdentitySwiftPasswordImportId(resourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"password",
+				},
+				ResourceName: resourceName,
+			},
 		},
 	})
 }
 
+func getIdentitySwiftPasswordImportId(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return getSwiftPasswordCompositeId(rs.Primary.Attributes["user_id"], rs.Primary.ID), nil
+	}
+}
+
 func testAccCheckIdentitySwiftPasswordDestroy(s *terraform.State) error {
 	noResourceFound := true
 	client := testAccProvider.Meta().(*OracleClients).identityClient
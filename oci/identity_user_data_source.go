@@ -63,6 +63,8 @@ func (s *IdentityUserDataSourceCrud) SetData() error {
 
 	s.D.SetId(*s.Res.Id)
 
+	s.D.Set("blocked", isUserBlocked(s.Res.InactiveStatus))
+
 	if s.Res.Capabilities != nil {
 		s.D.Set("capabilities", []interface{}{UserCapabilitiesToMap(s.Res.Capabilities)})
 	} else {
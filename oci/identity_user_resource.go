@@ -45,6 +45,18 @@ func IdentityUserResource() *schema.Resource {
 			},
 
 			// Optional
+			"blocked": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+				// The service only supports unblocking users, they are blocked after too many failed sign-in attempts
+				ValidateFunc: func(i interface{}, k string) ([]string, []error) {
+					if i.(bool) {
+						return nil, []error{fmt.Errorf("%s can only be set to false to unblock the user", k)}
+					}
+					return nil, nil
+				},
+			},
 			"defined_tags": {
 				Type:             schema.TypeMap,
 				Optional:         true,
@@ -307,6 +319,31 @@ func (s *IdentityUserResourceCrud) Update() error {
 		return err
 	}
 
+	s.Res = &response.User
+
+	if blocked, ok := s.D.GetOkExists("blocked"); ok && s.D.HasChange("blocked") && !blocked.(bool) {
+		return s.unblock()
+	}
+
+	return nil
+}
+
+func (s *IdentityUserResourceCrud) unblock() error {
+	request := oci_identity.UpdateUserStateRequest{}
+
+	tmp := s.D.Id()
+	request.UserId = &tmp
+
+	blocked := false
+	request.Blocked = &blocked
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "identity")
+
+	response, err := s.Client.UpdateUserState(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
 	s.Res = &response.User
 	return nil
 }
@@ -324,6 +361,8 @@ func (s *IdentityUserResourceCrud) Delete() error {
 }
 
 func (s *IdentityUserResourceCrud) SetData() error {
+	s.D.Set("blocked", isUserBlocked(s.Res.InactiveStatus))
+
 	if s.Res.Capabilities != nil {
 		s.D.Set("capabilities", []interface{}{UserCapabilitiesToMap(s.Res.Capabilities)})
 	} else {
@@ -398,3 +437,10 @@ func UserCapabilitiesToMap(obj *oci_identity.UserCapabilities) map[string]interf
 
 	return result
 }
+
+// The user is blocked when bit 2 of its inactive status is set
+const userInactiveStatusBlocked int64 = 1 << 2
+
+func isUserBlocked(inactiveStatus *int64) bool {
+	return inactiveStatus != nil && *inactiveStatus&userInactiveStatusBlocked != 0
+}
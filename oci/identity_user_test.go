@@ -37,6 +37,7 @@ var (
 	userRepresentation = map[string]interface{}{
 		"compartment_id": Representation{repType: Required, create: `${var.tenancy_ocid}`},
 		"description":    Representation{repType: Required, create: `John Smith`, update: `description2`},
+		"blocked":        Representation{repType: Optional, create: `false`},
 		"name":           Representation{repType: Required, create: `JohnSmith@example.com`},
 		"defined_tags":   Representation{repType: Optional, create: `${map("${oci_identity_tag_namespace.tag-namespace1.name}.${oci_identity_tag.tag1.name}", "value")}`, update: `${map("${oci_identity_tag_namespace.tag-namespace1.name}.${oci_identity_tag.tag1.name}", "updatedValue")}`},
 		"email":          Representation{repType: Optional, create: `email`, update: `email2`},
@@ -95,6 +96,7 @@ func TestIdentityUserResource_basic(t *testing.T) {
 				Config: config + compartmentIdVariableStr + UserResourceDependencies +
 					generateResourceFromRepresentationMap("oci_identity_user", "test_user", Optional, Create, userRepresentation),
 				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "blocked", "false"),
 					resource.TestCheckResourceAttr(resourceName, "compartment_id", tenancyId),
 					resource.TestCheckResourceAttr(resourceName, "defined_tags.%", "1"),
 					resource.TestCheckResourceAttr(resourceName, "description", "John Smith"),
@@ -118,6 +120,7 @@ func TestIdentityUserResource_basic(t *testing.T) {
 				Config: config + compartmentIdVariableStr + UserResourceDependencies +
 					generateResourceFromRepresentationMap("oci_identity_user", "test_user", Optional, Update, userRepresentation),
 				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "blocked", "false"),
 					resource.TestCheckResourceAttr(resourceName, "compartment_id", tenancyId),
 					resource.TestCheckResourceAttr(resourceName, "defined_tags.%", "1"),
 					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
@@ -118,6 +118,8 @@ func (s *IdentityUsersDataSourceCrud) SetData() error {
 			user["defined_tags"] = definedTagsToMap(r.DefinedTags)
 		}
 
+		user["blocked"] = isUserBlocked(r.InactiveStatus)
+
 		if r.Description != nil {
 			user["description"] = *r.Description
 		}
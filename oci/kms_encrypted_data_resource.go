@@ -4,6 +4,7 @@ package provider
 
 import (
 	"context"
+	"regexp"
 
 	"github.com/hashicorp/terraform/helper/schema"
 
@@ -15,6 +16,9 @@ import (
 
 func KmsEncryptedDataResource() *schema.Resource {
 	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: importKmsEncryptedData,
+		},
 		Timeouts: DefaultTimeout,
 		Create:   createKmsEncryptedData,
 		Read:     readKmsEncryptedData,
@@ -159,3 +163,55 @@ func (s *KmsEncryptedDataResourceCrud) SetData() error {
 
 	return nil
 }
+
+// Importing decrypts the ciphertext to recover the plaintext it was created from.
+// Ciphertexts that were encrypted with associated_data can't be imported.
+func importKmsEncryptedData(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	cryptoEndpoint, keyId, ciphertext, err := parseEncryptedDataCompositeId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := m.(*OracleClients).KmsCryptoClient(cryptoEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	request := oci_kms.DecryptRequest{}
+	request.Ciphertext = &ciphertext
+	request.KeyId = &keyId
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "kms")
+
+	response, err := client.Decrypt(context.Background(), request)
+	if err != nil {
+		return nil, err
+	}
+
+	// Same value as ID()
+	d.SetId(string(rune(hashcode.String(ciphertext))))
+	d.Set("crypto_endpoint", cryptoEndpoint)
+	d.Set("key_id", keyId)
+	d.Set("ciphertext", ciphertext)
+	if response.Plaintext != nil {
+		d.Set("plaintext", *response.Plaintext)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func getEncryptedDataCompositeId(cryptoEndpoint string, keyId string, ciphertext string) string {
+	return "cryptoEndpoint/" + cryptoEndpoint + "/keys/" + keyId + "/ciphertext/" + ciphertext
+}
+
+// The crypto endpoint is a URL and the ciphertext is base64 encoded, so both may contain '/'
+func parseEncryptedDataCompositeId(compositeId string) (cryptoEndpoint string, keyId string, ciphertext string, err error) {
+	regex, _ := regexp.Compile("^cryptoEndpoint/(.+)/keys/([^/]+)/ciphertext/(.+)$")
+	tokens := regex.FindStringSubmatch(compositeId)
+	if len(tokens) != 4 {
+		err = fmt.Errorf("illegal compositeId %s encountered, expected format: cryptoEndpoint/{cryptoEndpoint}/keys/{keyId}/ciphertext/{ciphertext}", compositeId)
+		return
+	}
+	cryptoEndpoint, keyId, ciphertext = tokens[1], tokens[2], tokens[3]
+
+	return
+}
@@ -103,3 +103,15 @@ func TestKmsEncryptedDataResource_basic(t *testing.T) {
 		},
 	})
 }
+
+func TestUnitParseEncryptedDataCompositeId(t *testing.T) {
+	compositeId := getEncryptedDataCompositeId("https://vault-crypto.kms.us-phoenix-1.oraclecloud.com", "ocid1.key.oc1..key", "AbC/dEf+gH==")
+	cryptoEndpoint, keyId, ciphertext, err := parseEncryptedDataCompositeId(compositeId)
+	if err != nil || cryptoEndpoint != "https://vault-crypto.kms.us-phoenix-1.oraclecloud.com" || keyId != "ocid1.key.oc1..key" || ciphertext != "AbC/dEf+gH==" {
+		t.Errorf("unexpected result %s %s %s: %v", cryptoEndpoint, keyId, ciphertext, err)
+	}
+
+	if _, _, _, err := parseEncryptedDataCompositeId("ocid1.key.oc1..key/AbC"); err == nil {
+		t.Errorf("expected an error for an invalid compositeId")
+	}
+}
@@ -4,6 +4,7 @@ package provider
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 
 	"github.com/hashicorp/terraform/helper/schema"
@@ -14,6 +15,9 @@ import (
 
 func KmsGeneratedKeyResource() *schema.Resource {
 	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: importKmsGeneratedKey,
+		},
 		Timeouts: DefaultTimeout,
 		Create:   createKmsGeneratedKey,
 		Read:     readKmsGeneratedKey,
@@ -201,3 +205,51 @@ func (s *KmsGeneratedKeyResourceCrud) mapToKeyShape(fieldKeyFormat string) (oci_
 
 	return result, nil
 }
+
+// Importing decrypts the ciphertext to recover the shape of the generated key. The plaintext key is not written to
+// the state, so imported keys are treated as generated with include_plaintext_key set to false.
+func importKmsGeneratedKey(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	cryptoEndpoint, keyId, ciphertext, err := parseEncryptedDataCompositeId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := m.(*OracleClients).KmsCryptoClient(cryptoEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	request := oci_kms.DecryptRequest{}
+	request.Ciphertext = &ciphertext
+	request.KeyId = &keyId
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "kms")
+
+	response, err := client.Decrypt(context.Background(), request)
+	if err != nil {
+		return nil, err
+	}
+	if response.Plaintext == nil {
+		return nil, fmt.Errorf("unable to decrypt ciphertext of generated key")
+	}
+
+	plaintextKey, err := base64.StdEncoding.DecodeString(*response.Plaintext)
+	if err != nil {
+		return nil, err
+	}
+
+	// Same value as ID()
+	d.SetId(string(rune(hashcode.String(ciphertext))))
+	d.Set("crypto_endpoint", cryptoEndpoint)
+	d.Set("key_id", keyId)
+	d.Set("ciphertext", ciphertext)
+	d.Set("include_plaintext_key", false)
+	d.Set("key_shape", []interface{}{map[string]interface{}{
+		"algorithm": string(oci_kms.KeyShapeAlgorithmAes),
+		"length":    len(plaintextKey),
+	}})
+	if response.PlaintextChecksum != nil {
+		d.Set("plaintext_checksum", *response.PlaintextChecksum)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
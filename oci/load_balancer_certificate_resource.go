@@ -5,6 +5,10 @@ package provider
 import (
 	"context"
 	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
 
@@ -13,6 +17,9 @@ import (
 
 func LoadBalancerCertificateResource() *schema.Resource {
 	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: importLoadBalancerCertificate,
+		},
 		Timeouts: DefaultTimeout,
 		Create:   createLoadBalancerCertificate,
 		Read:     readLoadBalancerCertificate,
@@ -283,3 +290,37 @@ func (s *LoadBalancerCertificateResourceCrud) SetData() error {
 
 	return nil
 }
+
+func importLoadBalancerCertificate(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	loadBalancerId, certificateName, err := parseCertificateCompositeId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(certificateName)
+	if err := d.Set("load_balancer_id", loadBalancerId); err != nil {
+		return nil, err
+	}
+	err = d.Set("certificate_name", certificateName)
+	return []*schema.ResourceData{d}, err
+}
+
+func getCertificateCompositeId(loadBalancerId string, certificateName string) string {
+	loadBalancerId = url.PathEscape(loadBalancerId)
+	certificateName = url.PathEscape(certificateName)
+	compositeId := "loadBalancers/" + loadBalancerId + "/certificates/" + certificateName
+	return compositeId
+}
+
+func parseCertificateCompositeId(compositeId string) (loadBalancerId string, certificateName string, err error) {
+	parts := strings.Split(compositeId, "/")
+	match, _ := regexp.MatchString("^loadBalancers/[^/]+/certificates/[^/]+$", compositeId)
+	if !match || len(parts) != 4 {
+		err = fmt.Errorf("illegal compositeId %s encountered, expected format: loadBalancers/{loadBalancerId}/certificates/{certificateName}", compositeId)
+		return
+	}
+	loadBalancerId, _ = url.PathUnescape(parts[1])
+	certificateName, _ = url.PathUnescape(parts[3])
+
+	return
+}
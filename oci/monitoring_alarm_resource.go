@@ -103,7 +103,6 @@ func MonitoringAlarmResource() *schema.Resource {
 			"suppression": {
 				Type:     schema.TypeList,
 				Optional: true,
-				Computed: true,
 				MaxItems: 1,
 				MinItems: 1,
 				Elem: &schema.Resource{
@@ -449,6 +448,13 @@ func (s *MonitoringAlarmResourceCrud) Update() error {
 		}
 	}
 
+	// Clearing the suppression block removes the suppression, UpdateAlarm would otherwise leave it in place
+	if alarmSuppressionRemoved(s.D) {
+		if err := s.removeSuppression(); err != nil {
+			return err
+		}
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "monitoring")
 
 	response, err := s.Client.UpdateAlarm(context.Background(), request)
@@ -460,6 +466,25 @@ func (s *MonitoringAlarmResourceCrud) Update() error {
 	return nil
 }
 
+// alarmSuppressionRemoved reports whether the suppression block was removed from the configuration. The diff of a removed
+// block still has suppression.# = 0, so the block is found to exist and only its contents tell that it was removed.
+func alarmSuppressionRemoved(d *schema.ResourceData) bool {
+	suppression, _ := d.Get("suppression").([]interface{})
+	return d.HasChange("suppression") && len(suppression) == 0
+}
+
+func (s *MonitoringAlarmResourceCrud) removeSuppression() error {
+	request := oci_monitoring.RemoveAlarmSuppressionRequest{}
+
+	tmp := s.D.Id()
+	request.AlarmId = &tmp
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "monitoring")
+
+	_, err := s.Client.RemoveAlarmSuppression(context.Background(), request)
+	return err
+}
+
 func (s *MonitoringAlarmResourceCrud) Delete() error {
 	request := oci_monitoring.DeleteAlarmRequest{}
 
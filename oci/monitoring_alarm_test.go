@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
@@ -235,6 +236,23 @@ func TestMonitoringAlarmResource_basic(t *testing.T) {
 					},
 				),
 			},
+			// verify clearing the suppression removes it
+			{
+				Config: config + compartmentIdVariableStr + AlarmResourceDependencies +
+					generateResourceFromRepresentationMap("oci_monitoring_alarm", "test_alarm", Optional, Update,
+						representationCopyWithRemovedProperties(alarmRepresentation, []string{"suppression"})),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "suppression.#", "0"),
+
+					func(s *terraform.State) (err error) {
+						resId2, err = fromInstanceState(s, resourceName, "id")
+						if resId != resId2 {
+							return fmt.Errorf("Resource recreated when it was supposed to be updated.")
+						}
+						return err
+					},
+				),
+			},
 			// verify datasource
 			{
 				Config: config +
@@ -435,3 +453,53 @@ func alarmSweepResponseFetchOperation(client *OracleClients, resourceId *string,
 	})
 	return err
 }
+
+func TestUnitMonitoringAlarmResource_suppressionRemoved(t *testing.T) {
+	var removed bool
+	suppressionResource := &schema.Resource{
+		Schema: map[string]*schema.Schema{"suppression": MonitoringAlarmResource().Schema["suppression"]},
+		Update: func(d *schema.ResourceData, m interface{}) error {
+			removed = alarmSuppressionRemoved(d)
+			return nil
+		},
+		Read: func(d *schema.ResourceData, m interface{}) error {
+			return nil
+		},
+	}
+	apply := func(attributes map[string]string, values map[string]interface{}) {
+		raw, err := config.NewRawConfig(values)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		state := &terraform.InstanceState{ID: "ocid1.alarm.oc1..aaaa", Attributes: attributes}
+		diff, err := suppressionResource.Diff(state, terraform.NewResourceConfig(raw), nil)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		removed = false
+		if _, err := suppressionResource.Apply(state, diff, nil); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+	suppressedAttributes := map[string]string{
+		"suppression.#":                     "1",
+		"suppression.0.description":         "System Maintenance",
+		"suppression.0.time_suppress_from":  "2126-02-01T18:00:00.001Z",
+		"suppression.0.time_suppress_until": "2126-02-01T19:00:00.001Z",
+	}
+	suppression := map[string]interface{}{
+		"description":         "description2",
+		"time_suppress_from":  "2125-12-01T18:00:00.001Z",
+		"time_suppress_until": "2125-12-01T19:00:00.001Z",
+	}
+
+	apply(suppressedAttributes, map[string]interface{}{})
+	if !removed {
+		t.Errorf("Expected removing the suppression block to remove the suppression")
+	}
+
+	apply(suppressedAttributes, map[string]interface{}{"suppression": []interface{}{suppression}})
+	if removed {
+		t.Errorf("Expected changing the suppression block to not remove the suppression")
+	}
+}
@@ -13,7 +13,7 @@ import (
 
 func MonitoringMetricDataDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readMonitoringMetricData,
+		Read: readMonitoringMetricDataDataSource,
 		Schema: map[string]*schema.Schema{
 			"filter": dataSourceFiltersSchema(),
 			"compartment_id": {
@@ -137,7 +137,7 @@ func MonitoringMetricDataDataSource() *schema.Resource {
 	}
 }
 
-func readMonitoringMetricData(d *schema.ResourceData, m interface{}) error {
+func readMonitoringMetricDataDataSource(d *schema.ResourceData, m interface{}) error {
 	sync := &MonitoringMetricDataDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*OracleClients).monitoringClient
@@ -0,0 +1,243 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	oci_common "github.com/oracle/oci-go-sdk/common"
+	oci_monitoring "github.com/oracle/oci-go-sdk/monitoring"
+)
+
+func MonitoringMetricDataResource() *schema.Resource {
+	return &schema.Resource{
+		Timeouts: DefaultTimeout,
+		Create:   createMonitoringMetricData,
+		Read:     readMonitoringMetricData,
+		Delete:   deleteMonitoringMetricData,
+		Schema: map[string]*schema.Schema{
+			// Required
+			"compartment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"datapoints": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// Required
+						"value": {
+							Type:     schema.TypeFloat,
+							Required: true,
+							ForceNew: true,
+						},
+
+						// Optional
+						"count": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+						"timestamp": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ForceNew:         true,
+							DiffSuppressFunc: timeDiffSuppressFunction,
+						},
+
+						// Computed
+					},
+				},
+			},
+			"dimensions": {
+				Type:     schema.TypeMap,
+				Required: true,
+				ForceNew: true,
+				Elem:     schema.TypeString,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"namespace": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// Optional
+			"metadata": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     schema.TypeString,
+			},
+
+			// Computed
+		},
+	}
+}
+
+func createMonitoringMetricData(d *schema.ResourceData, m interface{}) error {
+	sync := &MonitoringMetricDataResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).monitoringIngestionClient
+
+	return CreateResource(d, sync)
+}
+
+func readMonitoringMetricData(d *schema.ResourceData, m interface{}) error {
+	sync := &MonitoringMetricDataResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).monitoringIngestionClient
+
+	return ReadResource(sync)
+}
+
+// Posted datapoints cannot be deleted, they expire according to the retention of the Monitoring service
+func deleteMonitoringMetricData(d *schema.ResourceData, m interface{}) error {
+	return nil
+}
+
+type MonitoringMetricDataResourceCrud struct {
+	BaseCrud
+	Client     *oci_monitoring.MonitoringClient
+	Res        *oci_monitoring.PostMetricDataResponse
+	Datapoints []oci_monitoring.Datapoint
+}
+
+func (s *MonitoringMetricDataResourceCrud) ID() string {
+	return *s.Res.OpcRequestId
+}
+
+func (s *MonitoringMetricDataResourceCrud) Create() error {
+	request := oci_monitoring.PostMetricDataRequest{}
+
+	metricData := oci_monitoring.MetricDataDetails{}
+
+	compartmentId := s.D.Get("compartment_id").(string)
+	metricData.CompartmentId = &compartmentId
+
+	namespace := s.D.Get("namespace").(string)
+	metricData.Namespace = &namespace
+
+	name := s.D.Get("name").(string)
+	metricData.Name = &name
+
+	metricData.Dimensions = objectMapToStringMap(s.D.Get("dimensions").(map[string]interface{}))
+
+	if metadata, ok := s.D.GetOkExists("metadata"); ok {
+		metricData.Metadata = objectMapToStringMap(metadata.(map[string]interface{}))
+	}
+
+	interfaces := s.D.Get("datapoints").([]interface{})
+	datapoints := make([]oci_monitoring.Datapoint, len(interfaces))
+	for i := range interfaces {
+		fieldKeyFormat := fmt.Sprintf("%s.%d.%%s", "datapoints", i)
+		converted, err := s.mapToDatapoint(fieldKeyFormat)
+		if err != nil {
+			return err
+		}
+		datapoints[i] = converted
+	}
+	metricData.Datapoints = datapoints
+
+	request.MetricData = []oci_monitoring.MetricDataDetails{metricData}
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "monitoring")
+
+	response, err := s.Client.PostMetricData(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	if response.FailedMetricsCount != nil && *response.FailedMetricsCount > 0 {
+		message := fmt.Sprintf("failed to post %d metrics", *response.FailedMetricsCount)
+		for _, failed := range response.FailedMetrics {
+			if failed.Message != nil {
+				message += fmt.Sprintf("\n%s", *failed.Message)
+			}
+		}
+		return fmt.Errorf("%s", message)
+	}
+
+	s.Res = &response
+	s.Datapoints = datapoints
+	return nil
+}
+
+// Datapoints are immutable once posted, there is nothing to refresh
+func (s *MonitoringMetricDataResourceCrud) Get() error {
+	return nil
+}
+
+func (s *MonitoringMetricDataResourceCrud) SetData() error {
+	if s.Datapoints == nil {
+		return nil
+	}
+
+	datapoints := []interface{}{}
+	for _, item := range s.Datapoints {
+		datapoints = append(datapoints, MetricDatapointToMap(item))
+	}
+
+	if err := s.D.Set("datapoints", datapoints); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *MonitoringMetricDataResourceCrud) mapToDatapoint(fieldKeyFormat string) (oci_monitoring.Datapoint, error) {
+	result := oci_monitoring.Datapoint{}
+
+	if count, ok := s.D.GetOk(fmt.Sprintf(fieldKeyFormat, "count")); ok {
+		tmp := count.(int)
+		result.Count = &tmp
+	}
+
+	// Datapoints without a timestamp are posted at the time of the apply
+	timestamp := time.Now().UTC()
+	if timestampStr, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "timestamp")); ok && timestampStr.(string) != "" {
+		tmp, err := time.Parse(time.RFC3339, timestampStr.(string))
+		if err != nil {
+			return result, err
+		}
+		timestamp = tmp
+	}
+	result.Timestamp = &oci_common.SDKTime{Time: timestamp}
+
+	if value, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "value")); ok {
+		tmp := value.(float64)
+		result.Value = &tmp
+	}
+
+	return result, nil
+}
+
+func MetricDatapointToMap(obj oci_monitoring.Datapoint) map[string]interface{} {
+	result := map[string]interface{}{}
+
+	if obj.Count != nil {
+		result["count"] = *obj.Count
+	}
+
+	if obj.Timestamp != nil {
+		result["timestamp"] = obj.Timestamp.Format(time.RFC3339Nano)
+	}
+
+	if obj.Value != nil {
+		result["value"] = *obj.Value
+	}
+
+	return result
+}
@@ -29,6 +29,19 @@ var (
 	}
 
 	MetricDataResourceConfig = AvailabilityDomainConfig
+
+	metricDataRepresentation = map[string]interface{}{
+		"compartment_id": Representation{repType: Required, create: `${var.compartment_id}`},
+		"datapoints":     RepresentationGroup{Required, metricDataDatapointsRepresentation},
+		"dimensions":     Representation{repType: Required, create: map[string]string{"deploymentId": "1"}},
+		"name":           Representation{repType: Required, create: `deployment`},
+		"namespace":      Representation{repType: Required, create: `terraform_provider_test`},
+		"metadata":       Representation{repType: Optional, create: map[string]string{"unit": "count"}},
+	}
+	metricDataDatapointsRepresentation = map[string]interface{}{
+		"value": Representation{repType: Required, create: `1`},
+		"count": Representation{repType: Optional, create: `2`},
+	}
 )
 
 func generateMetricDataRepresentationWithCurrentTimeInputs() map[string]interface{} {
@@ -80,3 +93,42 @@ func TestMonitoringMetricDataResource_basic(t *testing.T) {
 		},
 	})
 }
+
+func TestMonitoringMetricDataResource_post(t *testing.T) {
+	httpreplay.SetScenario("TestMonitoringMetricDataResource_post")
+	defer httpreplay.SaveScenario()
+
+	provider := testAccProvider
+	config := testProviderConfig()
+
+	compartmentId := getEnvSettingWithBlankDefault("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	resourceName := "oci_monitoring_metric_data.test_metric_data"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Providers: map[string]terraform.ResourceProvider{
+			"oci": provider,
+		},
+		Steps: []resource.TestStep{
+			// verify posting the datapoints
+			{
+				Config: config + compartmentIdVariableStr +
+					generateResourceFromRepresentationMap("oci_monitoring_metric_data", "test_metric_data", Optional, Create, metricDataRepresentation),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "compartment_id", compartmentId),
+					resource.TestCheckResourceAttr(resourceName, "datapoints.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "datapoints.0.count", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "datapoints.0.timestamp"),
+					resource.TestCheckResourceAttr(resourceName, "datapoints.0.value", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dimensions.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "name", "deployment"),
+					resource.TestCheckResourceAttr(resourceName, "namespace", "terraform_provider_test"),
+				),
+			},
+		},
+	})
+}
@@ -4,6 +4,8 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	oci_object_storage "github.com/oracle/oci-go-sdk/objectstorage"
@@ -11,6 +13,9 @@ import (
 
 func ObjectStorageNamespaceMetadataResource() *schema.Resource {
 	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: importNamespaceMetadata,
+		},
 		Timeouts: DefaultTimeout,
 		Create:   readNamespaceMetadata,
 		Read:     readNamespaceMetadata,
@@ -133,3 +138,13 @@ func (s *NamespaceMetadataResourceCrud) SetData() error {
 
 	return nil
 }
+
+func importNamespaceMetadata(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	namespace := d.Id()
+	if namespace == "" || strings.Contains(namespace, "/") {
+		return nil, fmt.Errorf("id %s should be of format: {namespace}", namespace)
+	}
+
+	err := d.Set("namespace", namespace)
+	return []*schema.ResourceData{d}, err
+}
@@ -0,0 +1,343 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	oci_object_storage "github.com/oracle/oci-go-sdk/objectstorage"
+)
+
+func ObjectStorageObjectRestoreResource() *schema.Resource {
+	return &schema.Resource{
+		Timeouts: &schema.ResourceTimeout{
+			Create: &TwelveHours,
+			Delete: &FifteenMinutes,
+		},
+		Create: createObjectStorageObjectRestore,
+		Read:   readObjectStorageObjectRestore,
+		Delete: deleteObjectStorageObjectRestore,
+		Schema: map[string]*schema.Schema{
+			// Required
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"namespace": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// Optional
+			"hours": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      24,
+				ValidateFunc: validation.IntBetween(1, 240),
+			},
+			"object": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"prefix"},
+			},
+			"prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"object"},
+			},
+
+			// Computed
+			"objects": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"archival_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"object": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"time_of_archival": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func createObjectStorageObjectRestore(d *schema.ResourceData, m interface{}) error {
+	sync := &ObjectStorageObjectRestoreResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).objectStorageClient
+
+	return CreateResource(d, sync)
+}
+
+func readObjectStorageObjectRestore(d *schema.ResourceData, m interface{}) error {
+	sync := &ObjectStorageObjectRestoreResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).objectStorageClient
+
+	if err := ReadResource(sync); err != nil {
+		return err
+	}
+
+	// Restored objects return to the archive once the restore expires, restore them again on the next apply
+	if sync.Res != nil && sync.Res.State == string(oci_object_storage.HeadObjectArchivalStateArchived) {
+		sync.VoidState()
+	}
+
+	return nil
+}
+
+// Restored objects cannot be archived again on demand, they return to the archive once the restore expires
+func deleteObjectStorageObjectRestore(d *schema.ResourceData, m interface{}) error {
+	return nil
+}
+
+// ObjectStorageObjectRestore is the archival state of the restored objects, the state of the restore is the state of
+// the object that is the furthest from being readable
+type ObjectStorageObjectRestore struct {
+	State   string
+	Objects []ObjectStorageRestoredObject
+}
+
+type ObjectStorageRestoredObject struct {
+	Name     string
+	Response oci_object_storage.HeadObjectResponse
+}
+
+// Archival states ordered from the furthest from being readable to readable
+var objectArchivalStates = []oci_object_storage.HeadObjectArchivalStateEnum{
+	oci_object_storage.HeadObjectArchivalStateArchived,
+	oci_object_storage.HeadObjectArchivalStateRestoring,
+	oci_object_storage.HeadObjectArchivalStateRestored,
+	oci_object_storage.HeadObjectArchivalStateAvailable,
+}
+
+type ObjectStorageObjectRestoreResourceCrud struct {
+	BaseCrud
+	Client                 *oci_object_storage.ObjectStorageClient
+	Res                    *ObjectStorageObjectRestore
+	DisableNotFoundRetries bool
+}
+
+func (s *ObjectStorageObjectRestoreResourceCrud) ID() string {
+	if prefix, ok := s.D.GetOkExists("prefix"); ok {
+		return getObjectRestorePrefixCompositeId(s.D.Get("bucket").(string), s.D.Get("namespace").(string), prefix.(string))
+	}
+	return getObjectCompositeId(s.D.Get("bucket").(string), s.D.Get("namespace").(string), s.D.Get("object").(string))
+}
+
+func (s *ObjectStorageObjectRestoreResourceCrud) CreatedPending() []string {
+	return []string{
+		string(oci_object_storage.HeadObjectArchivalStateArchived),
+		string(oci_object_storage.HeadObjectArchivalStateRestoring),
+	}
+}
+
+func (s *ObjectStorageObjectRestoreResourceCrud) CreatedTarget() []string {
+	return []string{
+		string(oci_object_storage.HeadObjectArchivalStateRestored),
+		string(oci_object_storage.HeadObjectArchivalStateAvailable),
+	}
+}
+
+func (s *ObjectStorageObjectRestoreResourceCrud) Create() error {
+	namespace := s.D.Get("namespace").(string)
+	bucket := s.D.Get("bucket").(string)
+
+	headBucketRequest := oci_object_storage.HeadBucketRequest{}
+	headBucketRequest.NamespaceName = &namespace
+	headBucketRequest.BucketName = &bucket
+
+	headBucketRequest.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+
+	if _, err := s.Client.HeadBucket(context.Background(), headBucketRequest); err != nil {
+		return err
+	}
+
+	objectNames, err := s.getObjectNames()
+	if err != nil {
+		return err
+	}
+
+	objects := []interface{}{}
+	for _, objectName := range objectNames {
+		objects = append(objects, map[string]interface{}{"object": objectName})
+	}
+	s.D.Set("objects", objects)
+
+	if err := s.Get(); err != nil {
+		return err
+	}
+
+	hours := s.D.Get("hours").(int)
+	for _, object := range s.Res.Objects {
+		// Objects that are being restored or are readable are left as they are
+		if object.Response.ArchivalState != oci_object_storage.HeadObjectArchivalStateArchived {
+			continue
+		}
+
+		request := oci_object_storage.RestoreObjectsRequest{}
+		request.NamespaceName = &namespace
+		request.BucketName = &bucket
+
+		objectName := object.Name
+		request.RestoreObjectsDetails = oci_object_storage.RestoreObjectsDetails{
+			ObjectName: &objectName,
+			Hours:      &hours,
+		}
+
+		request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+
+		if _, err := s.Client.RestoreObjects(context.Background(), request); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getObjectNames returns the object to restore, or all the objects that start with the prefix
+func (s *ObjectStorageObjectRestoreResourceCrud) getObjectNames() ([]string, error) {
+	if object, ok := s.D.GetOkExists("object"); ok {
+		return []string{object.(string)}, nil
+	}
+
+	prefix, ok := s.D.GetOkExists("prefix")
+	if !ok {
+		return nil, fmt.Errorf("either object or prefix must be specified")
+	}
+
+	request := oci_object_storage.ListObjectsRequest{}
+
+	namespace := s.D.Get("namespace").(string)
+	request.NamespaceName = &namespace
+
+	bucket := s.D.Get("bucket").(string)
+	request.BucketName = &bucket
+
+	tmp := prefix.(string)
+	request.Prefix = &tmp
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+
+	objectNames := []string{}
+	for {
+		response, err := s.Client.ListObjects(context.Background(), request)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, object := range response.Objects {
+			if object.Name != nil {
+				objectNames = append(objectNames, *object.Name)
+			}
+		}
+
+		if response.NextStartWith == nil {
+			break
+		}
+		request.Start = response.NextStartWith
+	}
+
+	if len(objectNames) == 0 {
+		return nil, fmt.Errorf("no objects found with prefix %s in bucket %s", tmp, bucket)
+	}
+
+	return objectNames, nil
+}
+
+func (s *ObjectStorageObjectRestoreResourceCrud) Get() error {
+	namespace := s.D.Get("namespace").(string)
+	bucket := s.D.Get("bucket").(string)
+
+	res := &ObjectStorageObjectRestore{}
+	stateIndex := len(objectArchivalStates) - 1
+
+	for _, object := range s.D.Get("objects").([]interface{}) {
+		objectName := object.(map[string]interface{})["object"].(string)
+
+		request := oci_object_storage.HeadObjectRequest{}
+		request.NamespaceName = &namespace
+		request.BucketName = &bucket
+		request.ObjectName = &objectName
+
+		request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+
+		response, err := s.Client.HeadObject(context.Background(), request)
+		if err != nil {
+			return err
+		}
+
+		// The archival state header is not capitalized like the values of the enum
+		response.ArchivalState = oci_object_storage.HeadObjectArchivalStateEnum(strings.ToUpper(string(response.ArchivalState)))
+
+		res.Objects = append(res.Objects, ObjectStorageRestoredObject{Name: objectName, Response: response})
+
+		for i, state := range objectArchivalStates {
+			if response.ArchivalState == state && i < stateIndex {
+				stateIndex = i
+			}
+		}
+	}
+	res.State = string(objectArchivalStates[stateIndex])
+
+	s.Res = res
+	return nil
+}
+
+func (s *ObjectStorageObjectRestoreResourceCrud) SetData() error {
+	if s.Res == nil {
+		return nil
+	}
+
+	objects := []interface{}{}
+	for _, object := range s.Res.Objects {
+		result := map[string]interface{}{
+			"archival_state": string(object.Response.ArchivalState),
+			"object":         object.Name,
+		}
+
+		if object.Response.TimeOfArchival != nil {
+			result["time_of_archival"] = object.Response.TimeOfArchival.String()
+		}
+
+		objects = append(objects, result)
+	}
+	s.D.Set("objects", objects)
+
+	s.D.Set("state", s.Res.State)
+
+	return nil
+}
+
+func getObjectRestorePrefixCompositeId(bucket string, namespace string, prefix string) string {
+	bucket = url.PathEscape(bucket)
+	namespace = url.PathEscape(namespace)
+	prefix = url.PathEscape(prefix)
+	compositeId := "n/" + namespace + "/b/" + bucket + "/prefix/" + prefix
+	return compositeId
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/terraform-providers/terraform-provider-oci/httpreplay"
+)
+
+var (
+	objectRestoreRepresentation = map[string]interface{}{
+		"bucket":    Representation{repType: Required, create: `${oci_objectstorage_bucket.test_bucket.name}`},
+		"namespace": Representation{repType: Required, create: `${oci_objectstorage_bucket.test_bucket.namespace}`},
+		"hours":     Representation{repType: Optional, create: `1`},
+		"prefix":    Representation{repType: Optional, create: `my-test-archived-object`},
+		// The objects are listed by prefix, so they must exist before the restore
+		"depends_on": Representation{repType: Required, create: []string{`oci_objectstorage_object.test_object`, `oci_objectstorage_object.test_object2`}},
+	}
+
+	objectRestoreSingularDataSourceRepresentation = map[string]interface{}{
+		"bucket":     Representation{repType: Required, create: `${oci_objectstorage_bucket.test_bucket.name}`},
+		"namespace":  Representation{repType: Required, create: `${oci_objectstorage_bucket.test_bucket.namespace}`},
+		"object":     Representation{repType: Required, create: `${oci_objectstorage_object_restore.test_object_restore.objects.0.object}`},
+		"depends_on": Representation{repType: Required, create: []string{`oci_objectstorage_object_restore.test_object_restore`}},
+	}
+
+	ObjectRestoreResourceDependencies = `
+data "oci_objectstorage_namespace" "t" {
+}
+` +
+		generateResourceFromRepresentationMap("oci_objectstorage_bucket", "test_bucket", Required, Create,
+			representationCopyWithNewProperties(bucketRepresentation, map[string]interface{}{
+				"storage_tier": Representation{repType: Required, create: `Archive`},
+			})) +
+		generateResourceFromRepresentationMap("oci_objectstorage_object", "test_object", Required, Create,
+			representationCopyWithNewProperties(objectRepresentation, map[string]interface{}{
+				"content": Representation{repType: Required, create: `content`},
+				"object":  Representation{repType: Required, create: `my-test-archived-object-1`},
+			})) +
+		generateResourceFromRepresentationMap("oci_objectstorage_object", "test_object2", Required, Create,
+			representationCopyWithNewProperties(objectRepresentation, map[string]interface{}{
+				"content": Representation{repType: Required, create: `content`},
+				"object":  Representation{repType: Required, create: `my-test-archived-object-2`},
+			}))
+)
+
+func TestObjectStorageObjectRestoreResource_basic(t *testing.T) {
+	httpreplay.SetScenario("TestObjectStorageObjectRestoreResource_basic")
+	defer httpreplay.SaveScenario()
+
+	provider := testAccProvider
+	config := testProviderConfig()
+
+	compartmentId := getEnvSettingWithBlankDefault("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	resourceName := "oci_objectstorage_object_restore.test_object_restore"
+	singularDatasourceName := "data.oci_objectstorage_object.test_object"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Providers: map[string]terraform.ResourceProvider{
+			"oci": provider,
+		},
+		Steps: []resource.TestStep{
+			// verify restore of the objects with a prefix, and that they can be read once restored
+			{
+				Config: config + compartmentIdVariableStr + ObjectRestoreResourceDependencies +
+					generateResourceFromRepresentationMap("oci_objectstorage_object_restore", "test_object_restore", Optional, Create, objectRestoreRepresentation) +
+					generateDataSourceFromRepresentationMap("oci_objectstorage_object", "test_object", Required, Create, objectRestoreSingularDataSourceRepresentation),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "hours", "1"),
+					resource.TestCheckResourceAttr(resourceName, "objects.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "objects.0.archival_state", "RESTORED"),
+					resource.TestCheckResourceAttr(resourceName, "objects.0.object", "my-test-archived-object-1"),
+					resource.TestCheckResourceAttrSet(resourceName, "objects.0.time_of_archival"),
+					resource.TestCheckResourceAttr(resourceName, "objects.1.object", "my-test-archived-object-2"),
+					resource.TestCheckResourceAttr(resourceName, "prefix", "my-test-archived-object"),
+					resource.TestCheckResourceAttr(resourceName, "state", "RESTORED"),
+
+					resource.TestCheckResourceAttr(singularDatasourceName, "content", "content"),
+				),
+			},
+		},
+	})
+}
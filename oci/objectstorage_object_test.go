@@ -8,6 +8,7 @@ import (
 	"encoding/base64"
 	"encoding/hex"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"regexp"
 	"testing"
@@ -805,6 +806,133 @@ func createTmpObjectInOtherRegion() (string, error) {
 	return singlePartFile.Name(), nil
 }
 
+func TestObjectStorageObjectResource_resumeMultipartUpload(t *testing.T) {
+	httpreplay.SetScenario("TestObjectStorageObjectResource_resumeMultipartUpload")
+	defer httpreplay.SaveScenario()
+	provider := testAccProvider
+	config := testProviderConfig()
+
+	compartmentId := getEnvSettingWithBlankDefault("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	resourceName := "oci_objectstorage_object.test_object"
+
+	_, multiPartFilePath, err := createTmpFiles()
+	if err != nil {
+		t.Fatalf("Unable to create files to upload. Error: %q", err)
+	}
+
+	recordsDir, err := ioutil.TempDir("", "multipart-uploads")
+	if err != nil {
+		t.Fatalf("Unable to create the directory of the multi part upload records. Error: %q", err)
+	}
+	defer os.RemoveAll(recordsDir)
+	defaultRecordsDir := multipartUploadRecordsDir
+	multipartUploadRecordsDir = recordsDir
+	defer func() { multipartUploadRecordsDir = defaultRecordsDir }()
+
+	var namespace, interruptedUploadId, unrecordedUploadId string
+	objectName := "my-test-object-1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Providers: map[string]terraform.ResourceProvider{
+			"oci": provider,
+		},
+		CheckDestroy: testAccCheckObjectStorageObjectDestroy,
+		Steps: []resource.TestStep{
+			// create the bucket for the interrupted uploads
+			{
+				Config: config + compartmentIdVariableStr + ObjectResourceDependencies,
+				Check: func(s *terraform.State) (err error) {
+					namespace, err = fromInstanceState(s, "oci_objectstorage_bucket.test_bucket", "namespace")
+					return err
+				},
+			},
+			// verify the upload recorded by an interrupted apply is resumed and the upload that was not recorded, e.g. by an apply
+			// on another machine, is aborted
+			{
+				PreConfig: func() {
+					var err error
+					if unrecordedUploadId, err = createInterruptedMultipartUpload(namespace, testBucketName, objectName, multiPartFilePath); err != nil {
+						t.Fatalf("Unable to create the multi part upload that is not recorded. Error: %q", err)
+					}
+					if interruptedUploadId, err = createInterruptedMultipartUpload(namespace, testBucketName, objectName, multiPartFilePath); err != nil {
+						t.Fatalf("Unable to create the interrupted multi part upload. Error: %q", err)
+					}
+					record := multipartUploadRecord{
+						UploadId:    interruptedUploadId,
+						Fingerprint: multipartUploadFingerprint(oci_object_storage.CreateMultipartUploadDetails{Object: &objectName}),
+					}
+					if err := writeMultipartUploadRecord(multipartUploadRecordPath(namespace, testBucketName, objectName), record); err != nil {
+						t.Fatalf("Unable to record the interrupted multi part upload. Error: %q", err)
+					}
+				},
+				Config: config + compartmentIdVariableStr + ObjectResourceDependencies +
+					generateResourceFromRepresentationMap("oci_objectstorage_object", "test_object", Required, Create,
+						getUpdatedRepresentationCopy("source", Representation{repType: Optional, create: multiPartFilePath}, objectSourceRepresentation)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "content_length", strconv.Itoa(multiPartFileSize)),
+					resource.TestCheckResourceAttr(resourceName, "content_md5", opcMultipartMd5),
+					resource.TestCheckResourceAttr(resourceName, "object", objectName),
+
+					func(s *terraform.State) (err error) {
+						client := testAccProvider.Meta().(*OracleClients).objectStorageClient
+						response, err := client.ListMultipartUploads(context.Background(), oci_object_storage.ListMultipartUploadsRequest{
+							NamespaceName: &namespace,
+							BucketName:    &testBucketName,
+						})
+						if err != nil {
+							return err
+						}
+
+						if len(response.Items) != 0 {
+							return fmt.Errorf("expected the multi part upload %s that was not recorded to be aborted, found %v in progress", unrecordedUploadId, response.Items)
+						}
+						if _, err := os.Stat(multipartUploadRecordPath(namespace, testBucketName, objectName)); !os.IsNotExist(err) {
+							return fmt.Errorf("expected the record of the committed multi part upload to be removed, got %v", err)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+// createInterruptedMultipartUpload starts a multi part upload of the source and uploads its first part only
+func createInterruptedMultipartUpload(namespace string, bucket string, object string, source string) (string, error) {
+	client := testAccProvider.Meta().(*OracleClients).objectStorageClient
+
+	createResponse, err := client.CreateMultipartUpload(context.Background(), oci_object_storage.CreateMultipartUploadRequest{
+		NamespaceName: &namespace,
+		BucketName:    &bucket,
+		CreateMultipartUploadDetails: oci_object_storage.CreateMultipartUploadDetails{
+			Object: &object,
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	file, err := os.Open(source)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	_, err = client.UploadPart(context.Background(), oci_object_storage.UploadPartRequest{
+		NamespaceName:  &namespace,
+		BucketName:     &bucket,
+		ObjectName:     &object,
+		UploadId:       createResponse.UploadId,
+		UploadPartNum:  common.Int(1),
+		ContentLength:  common.Int64(defaultFilePartSize),
+		UploadPartBody: ioutil.NopCloser(io.NewSectionReader(file, 0, defaultFilePartSize)),
+	})
+	return *createResponse.UploadId, err
+}
+
 func TestObjectStorageObjectResource_crossRegionCopy(t *testing.T) {
 	httpreplay.SetScenario("TestObjectStorageObjectResource_crossRegionCopy")
 	defer httpreplay.SaveScenario()
@@ -15,6 +15,9 @@ import (
 
 func OnsSubscriptionResource() *schema.Resource {
 	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Timeouts: DefaultTimeout,
 		Create:   createOnsSubscription,
 		Read:     readOnsSubscription,
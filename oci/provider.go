@@ -28,6 +28,7 @@ import (
 	oci_common_auth "github.com/oracle/oci-go-sdk/common/auth"
 
 	"github.com/terraform-providers/terraform-provider-oci/httpreplay"
+	"github.com/terraform-providers/terraform-provider-oci/metrics"
 )
 
 var descriptions map[string]string
@@ -45,6 +46,8 @@ const (
 	authAPIKeySetting                     = "ApiKey"
 	authInstancePrincipalSetting          = "InstancePrincipal"
 	authInstancePrincipalWithCertsSetting = "InstancePrincipalWithCerts"
+	authSecurityTokenSetting              = "SecurityToken"
+	authResourcePrincipalSetting          = "ResourcePrincipal"
 	requestHeaderOpcOboToken              = "opc-obo-token"
 	requestHeaderOpcHostSerial            = "opc-host-serial"
 	defaultRequestTimeout                 = 0
@@ -59,17 +62,24 @@ const (
 	customCertLocationEnv                 = "custom_cert_location"
 	acceptLocalCerts                      = "accept_local_certs"
 
-	authAttrName                 = "auth"
-	tenancyOcidAttrName          = "tenancy_ocid"
-	userOcidAttrName             = "user_ocid"
-	fingerprintAttrName          = "fingerprint"
-	privateKeyAttrName           = "private_key"
-	privateKeyPathAttrName       = "private_key_path"
-	privateKeyPasswordAttrName   = "private_key_password"
-	regionAttrName               = "region"
-	disableAutoRetriesAttrName   = "disable_auto_retries"
-	retryDurationSecondsAttrName = "retry_duration_seconds"
-	oboTokenAttrName             = "obo_token"
+	authAttrName                     = "auth"
+	tenancyOcidAttrName              = "tenancy_ocid"
+	userOcidAttrName                 = "user_ocid"
+	fingerprintAttrName              = "fingerprint"
+	privateKeyAttrName               = "private_key"
+	privateKeyPathAttrName           = "private_key_path"
+	privateKeyPasswordAttrName       = "private_key_password"
+	regionAttrName                   = "region"
+	disableAutoRetriesAttrName       = "disable_auto_retries"
+	retryDurationSecondsAttrName     = "retry_duration_seconds"
+	oboTokenAttrName                 = "obo_token"
+	configFileProfileAttrName        = "config_file_profile"
+	configFilePathAttrName           = "config_file_path"
+	requestsPerSecondAttrName        = "requests_per_second"
+	serviceRequestsPerSecondAttrName = "service_requests_per_second"
+	metricsAttrName                  = "metrics"
+	metricsFormatAttrName            = "format"
+	metricsPathAttrName              = "path"
 
 	tfEnvPrefix  = "TF_VAR_"
 	ociEnvPrefix = "OCI_"
@@ -105,7 +115,7 @@ func ociVarName(attrName string) string {
 
 func init() {
 	descriptions = map[string]string{
-		authAttrName:        fmt.Sprintf("(Optional) The type of auth to use. Options are '%s' and '%s'. By default, '%s' will be used.", authAPIKeySetting, authInstancePrincipalSetting, authAPIKeySetting),
+		authAttrName:        fmt.Sprintf("(Optional) The type of auth to use. Options are '%s', '%s', '%s' and '%s'. By default, '%s' will be used.", authAPIKeySetting, authInstancePrincipalSetting, authSecurityTokenSetting, authResourcePrincipalSetting, authAPIKeySetting),
 		tenancyOcidAttrName: fmt.Sprintf("(Optional) The tenancy OCID for a user. The tenancy OCID can be found at the bottom of user settings in the Oracle Cloud Infrastructure console. Required if auth is set to '%s', ignored otherwise.", authAPIKeySetting),
 		userOcidAttrName:    fmt.Sprintf("(Optional) The user OCID. This can be found in user settings in the Oracle Cloud Infrastructure console. Required if auth is set to '%s', ignored otherwise.", authAPIKeySetting),
 		fingerprintAttrName: fmt.Sprintf("(Optional) The fingerprint for the user's RSA key. This can be found in user settings in the Oracle Cloud Infrastructure console. Required if auth is set to '%s', ignored otherwise.", authAPIKeySetting),
@@ -119,6 +129,16 @@ func init() {
 			"Automatic retries were introduced to solve some eventual consistency problems but it also introduced performance issues on destroy operations.",
 		retryDurationSecondsAttrName: "(Optional) The minimum duration (in seconds) to retry a resource operation in response to an error.\n" +
 			"The actual retry duration may be longer due to jittering of retry operations. This value is ignored if the `disable_auto_retries` field is set to true.",
+		configFileProfileAttrName: fmt.Sprintf("(Optional) The profile of the OCI config file to read the configuration from. The '%s' profile is used if only config_file_path is set.", defaultConfigFileProfile),
+		configFilePathAttrName:    fmt.Sprintf("(Optional) The path of the OCI config file to read the configuration from. '%s' is used if only config_file_profile is set.", defaultConfigFilePath),
+		requestsPerSecondAttrName: "(Optional) The maximum number of requests per second sent to all the services.\n" +
+			"Requests are delayed to stay under the limit instead of being throttled by the services. By default, the requests are not limited.",
+		serviceRequestsPerSecondAttrName: "(Optional) The maximum number of requests per second sent to a service, keyed by the service of the endpoint (e.g. iaas, identity, database, objectstorage).\n" +
+			"It applies in addition to requests_per_second.",
+		metricsAttrName: "(Optional) Write the duration of the operations on the resources and a record of every call to the OCI services to a file.\n" +
+			"The block can be repeated to write the metrics to several files.",
+		metricsFormatAttrName: fmt.Sprintf("The format of the metrics, one of %s.", strings.Join(metrics.Formats, ", ")),
+		metricsPathAttrName:   "The path of the file that the metrics are written to.",
 	}
 }
 
@@ -140,7 +160,7 @@ func schemaMap() map[string]*schema.Schema {
 			Optional:     true,
 			Description:  descriptions[authAttrName],
 			DefaultFunc:  schema.MultiEnvDefaultFunc([]string{tfVarName(authAttrName), ociVarName(authAttrName)}, authAPIKeySetting),
-			ValidateFunc: validation.StringInSlice([]string{authAPIKeySetting, authInstancePrincipalSetting, authInstancePrincipalWithCertsSetting}, true),
+			ValidateFunc: validation.StringInSlice([]string{authAPIKeySetting, authInstancePrincipalSetting, authInstancePrincipalWithCertsSetting, authSecurityTokenSetting, authResourcePrincipalSetting}, true),
 		},
 		tenancyOcidAttrName: {
 			Type:        schema.TypeString,
@@ -202,11 +222,57 @@ func schemaMap() map[string]*schema.Schema {
 			Description: descriptions[retryDurationSecondsAttrName],
 			DefaultFunc: schema.MultiEnvDefaultFunc([]string{tfVarName(retryDurationSecondsAttrName), ociVarName(retryDurationSecondsAttrName)}, nil),
 		},
+		configFileProfileAttrName: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: descriptions[configFileProfileAttrName],
+			DefaultFunc: schema.MultiEnvDefaultFunc([]string{tfVarName(configFileProfileAttrName), ociVarName(configFileProfileAttrName)}, nil),
+		},
+		configFilePathAttrName: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: descriptions[configFilePathAttrName],
+			DefaultFunc: schema.MultiEnvDefaultFunc([]string{tfVarName(configFilePathAttrName), ociVarName(configFilePathAttrName)}, nil),
+		},
+		requestsPerSecondAttrName: {
+			Type:        schema.TypeFloat,
+			Optional:    true,
+			Description: descriptions[requestsPerSecondAttrName],
+			DefaultFunc: schema.MultiEnvDefaultFunc([]string{tfVarName(requestsPerSecondAttrName), ociVarName(requestsPerSecondAttrName)}, nil),
+		},
+		serviceRequestsPerSecondAttrName: {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Description: descriptions[serviceRequestsPerSecondAttrName],
+			Elem: &schema.Schema{
+				Type: schema.TypeFloat,
+			},
+		},
+		metricsAttrName: {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: descriptions[metricsAttrName],
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					metricsFormatAttrName: {
+						Type:         schema.TypeString,
+						Required:     true,
+						Description:  descriptions[metricsFormatAttrName],
+						ValidateFunc: validation.StringInSlice(metrics.Formats, false),
+					},
+					metricsPathAttrName: {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: descriptions[metricsPathAttrName],
+					},
+				},
+			},
+		},
 	}
 }
 
 func dataSourcesMap() map[string]*schema.Resource {
-	return map[string]*schema.Resource{
+	return addDataSourcesRegionOverride(map[string]*schema.Resource{
 		"oci_audit_configuration":                               AuditConfigurationDataSource(),
 		"oci_audit_events":                                      AuditAuditEventsDataSource(),
 		"oci_budget_budget":                                     BudgetBudgetDataSource(),
@@ -388,6 +454,7 @@ func dataSourcesMap() map[string]*schema.Resource {
 		"oci_identity_identity_providers":                       IdentityIdentityProvidersDataSource(),
 		"oci_identity_identity_provider_groups":                 IdentityIdentityProviderGroupsDataSource(),
 		"oci_identity_idp_group_mappings":                       IdentityIdpGroupMappingsDataSource(),
+		"oci_identity_mfa_totp_devices":                         IdentityMfaTotpDevicesDataSource(),
 		"oci_identity_cost_tracking_tags":                       IdentityCostTrackingTagsDataSource(),
 		"oci_identity_ui_password":                              IdentityUiPasswordDataSource(),
 		"oci_identity_policies":                                 IdentityPoliciesDataSource(),
@@ -450,18 +517,24 @@ func dataSourcesMap() map[string]*schema.Resource {
 		"oci_ons_notification_topics":                           OnsNotificationTopicsDataSource(),
 		"oci_ons_subscription":                                  OnsSubscriptionDataSource(),
 		"oci_ons_subscriptions":                                 OnsSubscriptionsDataSource(),
+		"oci_streaming_messages":                                StreamingMessagesDataSource(),
 		"oci_streaming_stream":                                  StreamingStreamDataSource(),
 		"oci_streaming_streams":                                 StreamingStreamsDataSource(),
 		"oci_waas_waas_policy":                                  WaasWaasPolicyDataSource(),
+		"oci_waas_waf_blocked_requests":                         WaasWafBlockedRequestsDataSource(),
+		"oci_waas_waf_logs":                                     WaasWafLogsDataSource(),
+		"oci_waas_waf_requests":                                 WaasWafRequestsDataSource(),
+		"oci_waas_waf_traffic":                                  WaasWafTrafficDataSource(),
 		"oci_waas_waas_policies":                                WaasWaasPoliciesDataSource(),
 		"oci_waas_certificate":                                  WaasCertificateDataSource(),
 		"oci_waas_certificates":                                 WaasCertificatesDataSource(),
 		"oci_waas_edge_subnets":                                 WaasEdgeSubnetsDataSource(),
-	}
+		"oci_waas_recommendations":                              WaasRecommendationsDataSource(),
+	})
 }
 
 func resourcesMap() map[string]*schema.Resource {
-	return map[string]*schema.Resource{
+	return addResourcesDurationMetrics(addResourcesRegionOverride(map[string]*schema.Resource{
 		"oci_autoscaling_auto_scaling_configuration":              AutoScalingAutoScalingConfigurationResource(),
 		"oci_budget_budget":                                       BudgetBudgetResource(),
 		"oci_budget_alert_rule":                                   BudgetAlertRuleResource(),
@@ -469,6 +542,7 @@ func resourcesMap() map[string]*schema.Resource {
 		"oci_core_listing_resource_version_agreement":             AppCatalogListingResourceVersionAgreementResource(),
 		"oci_core_app_catalog_subscription":                       CoreAppCatalogSubscriptionResource(),
 		"oci_core_boot_volume":                                    CoreBootVolumeResource(),
+		"oci_core_boot_volume_attachment":                         CoreBootVolumeAttachmentResource(),
 		"oci_core_boot_volume_backup":                             CoreBootVolumeBackupResource(),
 		"oci_audit_configuration":                                 AuditConfigurationResource(),
 		"oci_containerengine_cluster":                             ContainerengineClusterResource(),
@@ -482,9 +556,11 @@ func resourcesMap() map[string]*schema.Resource {
 		"oci_core_drg":                                            CoreDrgResource(),
 		"oci_core_drg_attachment":                                 CoreDrgAttachmentResource(),
 		"oci_core_image":                                          CoreImageResource(),
+		"oci_core_image_export":                                   CoreImageExportResource(),
 		"oci_core_instance":                                       CoreInstanceResource(),
 		"oci_core_instance_console_connection":                    CoreInstanceConsoleConnectionResource(),
 		"oci_core_instance_configuration":                         CoreInstanceConfigurationResource(),
+		"oci_core_instance_configuration_instance":                CoreInstanceConfigurationInstanceResource(),
 		"oci_core_instance_pool":                                  CoreInstancePoolResource(),
 		"oci_core_internet_gateway":                               CoreInternetGatewayResource(),
 		"oci_core_ipsec":                                          CoreIpSecConnectionResource(),
@@ -526,6 +602,8 @@ func resourcesMap() map[string]*schema.Resource {
 		"oci_database_backup":                                     DatabaseBackupResource(),
 		"oci_database_maintenance_run":                            DatabaseMaintenanceRunResource(),
 		"oci_dns_record":                                          DnsRecordResource(),
+		"oci_dns_rrset":                                           DnsRrsetResource(),
+		"oci_dns_zone_records":                                    DnsZoneRecordsResource(),
 		"oci_dns_steering_policy":                                 DnsSteeringPolicyResource(),
 		"oci_dns_steering_policy_attachment":                      DnsSteeringPolicyAttachmentResource(),
 		"oci_dns_zone":                                            DnsZoneResource(),
@@ -553,7 +631,9 @@ func resourcesMap() map[string]*schema.Resource {
 		"oci_identity_group":                                      IdentityGroupResource(),
 		"oci_identity_identity_provider":                          IdentityIdentityProviderResource(),
 		"oci_identity_idp_group_mapping":                          IdentityIdpGroupMappingResource(),
+		"oci_identity_mfa_totp_device":                            IdentityMfaTotpDeviceResource(),
 		"oci_identity_policy":                                     IdentityPolicyResource(),
+		"oci_identity_region_subscription":                        IdentityRegionSubscriptionResource(),
 		"oci_identity_smtp_credential":                            IdentitySmtpCredentialResource(),
 		"oci_identity_swift_password":                             IdentitySwiftPasswordResource(),
 		"oci_identity_tag":                                        IdentityTagResource(),
@@ -580,17 +660,22 @@ func resourcesMap() map[string]*schema.Resource {
 		"oci_load_balancer_path_route_set":                        LoadBalancerPathRouteSetResource(),
 		"oci_load_balancer_rule_set":                              LoadBalancerRuleSetResource(),
 		"oci_monitoring_alarm":                                    MonitoringAlarmResource(),
+		"oci_monitoring_metric_data":                              MonitoringMetricDataResource(),
 		"oci_objectstorage_bucket":                                ObjectStorageBucketResource(),
 		"oci_objectstorage_object_lifecycle_policy":               ObjectStorageObjectLifecyclePolicyResource(),
 		"oci_objectstorage_object":                                ObjectStorageObjectResource(),
+		"oci_objectstorage_object_restore":                        ObjectStorageObjectRestoreResource(),
 		"oci_objectstorage_namespace_metadata":                    ObjectStorageNamespaceMetadataResource(),
 		"oci_objectstorage_preauthrequest":                        ObjectStoragePreauthenticatedRequestResource(),
 		"oci_ons_notification_topic":                              OnsNotificationTopicResource(),
 		"oci_ons_subscription":                                    OnsSubscriptionResource(),
+		"oci_streaming_messages":                                  StreamingMessagesResource(),
 		"oci_streaming_stream":                                    StreamingStreamResource(),
 		"oci_waas_waas_policy":                                    WaasWaasPolicyResource(),
 		"oci_waas_certificate":                                    WaasCertificateResource(),
-	}
+		"oci_waas_protection_rule":                                WaasProtectionRuleResource(),
+		"oci_waas_threat_feed":                                    WaasThreatFeedResource(),
+	}))
 }
 
 func getEnvSettingWithBlankDefault(s string) string {
@@ -660,6 +745,15 @@ func ProviderConfig(d *schema.ResourceData) (interface{}, error) {
 		configuredRetryDuration = &val
 	}
 
+	serviceRequestsPerSecond := map[string]float64{}
+	for service, rate := range d.Get(serviceRequestsPerSecondAttrName).(map[string]interface{}) {
+		serviceRequestsPerSecond[service] = rate.(float64)
+	}
+	limiter, err := newRequestRateLimiter(d.Get(requestsPerSecondAttrName).(float64), serviceRequestsPerSecond)
+	if err != nil {
+		return nil, err
+	}
+
 	auth := strings.ToLower(d.Get(authAttrName).(string))
 	clients.configuration[authAttrName] = auth
 
@@ -670,12 +764,25 @@ func ProviderConfig(d *schema.ResourceData) (interface{}, error) {
 
 	configProviders = append(configProviders, ResourceDataConfigProvider{d})
 
-	// TODO: DefaultConfigProvider will return us a composingConfigurationProvider that reads from SDK config files,
-	// and then from the environment variables ("TF_VAR" prefix). References to "TF_VAR" prefix should be removed from
-	// the SDK, since it's Terraform specific. When that happens, we need to update this to pass in the right prefix.
-	configProviders = append(configProviders, oci_common.DefaultConfigProvider())
+	if configFilePath, profile, ok := getConfigFileProfile(d); ok {
+		configFileProvider, err := newConfigFileConfigurationProvider(configFilePath, profile, d.Get(privateKeyPasswordAttrName).(string))
+		if err != nil {
+			return nil, err
+		}
+		configProviders = append(configProviders, configFileProvider)
+	} else {
+		// TODO: DefaultConfigProvider will return us a composingConfigurationProvider that reads from SDK config files,
+		// and then from the environment variables ("TF_VAR" prefix). References to "TF_VAR" prefix should be removed from
+		// the SDK, since it's Terraform specific. When that happens, we need to update this to pass in the right prefix.
+		configProviders = append(configProviders, oci_common.DefaultConfigProvider())
+	}
 
-	sdkConfigProvider, err := oci_common.ComposingConfigurationProvider(configProviders)
+	sdkConfigProvider, err := newComposingConfigurationProvider(configProviders)
+	if err != nil {
+		return nil, err
+	}
+
+	metricsRecorder, err := newMetricsRecorder(d, sdkConfigProvider)
 	if err != nil {
 		return nil, err
 	}
@@ -683,7 +790,7 @@ func ProviderConfig(d *schema.ResourceData) (interface{}, error) {
 	httpClient := buildHttpClient()
 
 	// beware: global variable `configureClient` set here--used elsewhere outside this execution path
-	configureClient, err = buildConfigureClientFn(sdkConfigProvider, httpClient)
+	configureClient, err = buildConfigureClientFn(sdkConfigProvider, httpClient, limiter, metricsRecorder)
 	if err != nil {
 		return nil, err
 	}
@@ -692,12 +799,38 @@ func ProviderConfig(d *schema.ResourceData) (interface{}, error) {
 	if err != nil {
 		return nil, err
 	}
+	clients.metricsRecorder = metricsRecorder
+
+	// clients for the `region` argument of resources and data sources are created on first use
+	clients.regionalClients = newRegionalClientsCache(clients, sdkConfigProvider, configureClient)
 
 	avoidWaitingForDeleteTarget, _ = strconv.ParseBool(getEnvSettingWithDefault("avoid_waiting_for_delete_target", "false"))
 
 	return clients, nil
 }
 
+// newMetricsRecorder returns the recorder of the sinks of the `metrics` blocks, or nil when metrics are disabled.
+// Every provider block, including every alias, has its own recorder; its regional clients share it.
+func newMetricsRecorder(d *schema.ResourceData, configProvider oci_common.ConfigurationProvider) (*metrics.Recorder, error) {
+	var sinks []metrics.Sink
+	for _, sinkConfig := range d.Get(metricsAttrName).([]interface{}) {
+		sinkMap := sinkConfig.(map[string]interface{})
+		sink, err := metrics.NewSink(sinkMap[metricsFormatAttrName].(string), expandPath(sinkMap[metricsPathAttrName].(string)))
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, sink)
+	}
+
+	// there are default sinks without sinks in the provider block when the provider is built with the `metrics` tag
+	if len(sinks) == 0 && !metrics.HasDefaultSinks() {
+		return nil, nil
+	}
+	tenancyOcid, _ := configProvider.TenancyOCID()
+	region, _ := configProvider.Region()
+	return metrics.NewRecorder(tenancyOcid, region, sinks...), nil
+}
+
 func getConfigProviders(d *schema.ResourceData, auth string) ([]oci_common.ConfigurationProvider, error) {
 	var configProviders []oci_common.ConfigurationProvider
 
@@ -767,13 +900,55 @@ func getConfigProviders(d *schema.ResourceData, auth string) ([]oci_common.Confi
 			return nil, err
 		}
 		configProviders = append(configProviders, cfg)
+	case strings.ToLower(authSecurityTokenSetting):
+		apiKeyConfigVariablesToUnset, ok := checkIncompatibleAttrsForApiKeyAuth(d)
+		if !ok {
+			return nil, fmt.Errorf(`user credentials %v should be removed from the configuration`, strings.Join(apiKeyConfigVariablesToUnset, ", "))
+		}
+
+		configFilePath, profile, _ := getConfigFileProfile(d)
+		region, _ := d.GetOkExists(regionAttrName)
+		cfg, err := newSecurityTokenConfigurationProvider(configFilePath, profile, region.(string))
+		if err != nil {
+			return nil, err
+		}
+		configProviders = append(configProviders, cfg)
+	case strings.ToLower(authResourcePrincipalSetting):
+		apiKeyConfigVariablesToUnset, ok := checkIncompatibleAttrsForApiKeyAuth(d)
+		if !ok {
+			return nil, fmt.Errorf(`user credentials %v should be removed from the configuration`, strings.Join(apiKeyConfigVariablesToUnset, ", "))
+		}
+
+		region, _ := d.GetOkExists(regionAttrName)
+		cfg, err := newResourcePrincipalConfigurationProvider(region.(string))
+		if err != nil {
+			return nil, err
+		}
+		configProviders = append(configProviders, cfg)
 	default:
-		return nil, fmt.Errorf("auth must be one of '%s' or '%s' or '%s'", authAPIKeySetting, authInstancePrincipalSetting, authInstancePrincipalWithCertsSetting)
+		return nil, fmt.Errorf("auth must be one of '%s' or '%s' or '%s' or '%s' or '%s'", authAPIKeySetting, authInstancePrincipalSetting, authInstancePrincipalWithCertsSetting, authSecurityTokenSetting, authResourcePrincipalSetting)
 	}
 
 	return configProviders, nil
 }
 
+// getConfigFileProfile returns the OCI config file and profile to read the configuration from, ok is false if neither is configured
+func getConfigFileProfile(d *schema.ResourceData) (configFilePath string, profile string, ok bool) {
+	configFilePath, profile = defaultConfigFilePath, defaultConfigFileProfile
+
+	if value, exists := d.GetOkExists(configFilePathAttrName); exists && value.(string) != "" {
+		configFilePath = value.(string)
+		ok = true
+	}
+
+	if value, exists := d.GetOkExists(configFileProfileAttrName); exists && value.(string) != "" {
+		profile = value.(string)
+		ok = true
+	}
+
+	return
+}
+
 func buildHttpClient() (httpClient *http.Client) {
 	httpClient = &http.Client{
 		Timeout: defaultRequestTimeout,
@@ -789,7 +964,7 @@ func buildHttpClient() (httpClient *http.Client) {
 	return
 }
 
-func buildConfigureClientFn(configProvider oci_common.ConfigurationProvider, httpClient *http.Client) (ConfigureClient, error) {
+func buildConfigureClientFn(configProvider oci_common.ConfigurationProvider, httpClient *http.Client, limiter *requestRateLimiter, metricsRecorder *metrics.Recorder) (ConfigureClient, error) {
 
 	if ociProvider != nil && len(ociProvider.TerraformVersion) > 0 {
 		terraformCLIVersion = ociProvider.TerraformVersion
@@ -819,6 +994,12 @@ func buildConfigureClientFn(configProvider oci_common.ConfigurationProvider, htt
 		client.UserAgent = userAgent
 		client.Signer = requestSigner
 		client.Interceptor = func(r *http.Request) error {
+			if limiter != nil {
+				if err := limiter.wait(r); err != nil {
+					return err
+				}
+			}
+
 			if oboToken, err := oboTokenProvider.OboToken(); err == nil && oboToken != "" {
 				r.Header.Set(requestHeaderOpcOboToken, oboToken)
 			}
@@ -871,6 +1052,10 @@ func buildConfigureClientFn(configProvider oci_common.ConfigurationProvider, htt
 			}
 		}
 
+		if metricsRecorder.ShouldWriteMetrics() {
+			client.HTTPClient = apiCallMetricsDispatcher{client.HTTPClient, metricsRecorder}
+		}
+
 		return nil
 	}
 
@@ -881,9 +1066,8 @@ type ResourceDataConfigProvider struct {
 	D *schema.ResourceData
 }
 
-// TODO: The error messages returned by following methods get swallowed up by the ComposingConfigurationProvider,
-// since it only checks whether an error exists or not.
-// The ComposingConfigurationProvider in SDK should log the errors as debug statements instead.
+// The error messages returned by following methods are reported by the composingConfigurationProvider
+// when none of the configuration providers has the value.
 
 func (p ResourceDataConfigProvider) TenancyOCID() (string, error) {
 	if tenancyOCID, ok := p.D.GetOkExists(tenancyOcidAttrName); ok {
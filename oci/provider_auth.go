@@ -0,0 +1,483 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/mitchellh/go-homedir"
+	oci_common "github.com/oracle/oci-go-sdk/common"
+)
+
+const (
+	defaultConfigFilePath    = "~/.oci/config"
+	defaultConfigFileProfile = "DEFAULT"
+
+	// Requests signed with a security token use the token as the key ID
+	securityTokenKeyIdPrefix = "ST$"
+
+	resourcePrincipalVersionEnv              = "OCI_RESOURCE_PRINCIPAL_VERSION"
+	resourcePrincipalRpstEnv                 = "OCI_RESOURCE_PRINCIPAL_RPST"
+	resourcePrincipalPrivatePemEnv           = "OCI_RESOURCE_PRINCIPAL_PRIVATE_PEM"
+	resourcePrincipalPrivatePemPassphraseEnv = "OCI_RESOURCE_PRINCIPAL_PRIVATE_PEM_PASSPHRASE"
+	resourcePrincipalRegionEnv               = "OCI_RESOURCE_PRINCIPAL_REGION"
+	resourcePrincipalVersion2_2              = "2.2"
+)
+
+// securityTokenConfigurationProvider signs requests with the session token and key of a profile of the OCI config file,
+// as created by `oci session authenticate`
+type securityTokenConfigurationProvider struct {
+	profile           string
+	tenancyOCID       string
+	region            string
+	securityTokenFile string
+	privateKey        *rsa.PrivateKey
+}
+
+func newSecurityTokenConfigurationProvider(configFilePath string, profile string, region string) (oci_common.ConfigurationProvider, error) {
+	values, err := readConfigFileProfile(configFilePath, profile)
+	if err != nil {
+		return nil, err
+	}
+
+	securityTokenFile, ok := values["security_token_file"]
+	if !ok {
+		return nil, fmt.Errorf("profile %s of the configuration file %s does not contain security_token_file, it is required for SecurityToken authentication", profile, configFilePath)
+	}
+
+	keyFile, ok := values["key_file"]
+	if !ok {
+		return nil, fmt.Errorf("profile %s of the configuration file %s does not contain key_file, it is required for SecurityToken authentication", profile, configFilePath)
+	}
+
+	pemFileContent, err := ioutil.ReadFile(expandPath(keyFile))
+	if err != nil {
+		return nil, fmt.Errorf("can not read the session key of profile %s from: '%s', Error: %q", profile, keyFile, err)
+	}
+
+	var passphrase *string
+	if value, ok := values["pass_phrase"]; ok {
+		passphrase = &value
+	}
+
+	privateKey, err := oci_common.PrivateKeyFromBytes(pemFileContent, passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("can not parse the session key of profile %s from: '%s', Error: %q", profile, keyFile, err)
+	}
+
+	if region == "" {
+		region = values["region"]
+	}
+
+	provider := securityTokenConfigurationProvider{
+		profile:           profile,
+		tenancyOCID:       values["tenancy"],
+		region:            region,
+		securityTokenFile: expandPath(securityTokenFile),
+		privateKey:        privateKey,
+	}
+
+	if _, err := provider.KeyID(); err != nil {
+		return nil, err
+	}
+
+	return provider, nil
+}
+
+func (p securityTokenConfigurationProvider) PrivateRSAKey() (*rsa.PrivateKey, error) {
+	return p.privateKey, nil
+}
+
+// KeyID reads the token on every call, so that a session refreshed with `oci session refresh` is picked up
+func (p securityTokenConfigurationProvider) KeyID() (string, error) {
+	content, err := ioutil.ReadFile(p.securityTokenFile)
+	if err != nil {
+		return "", fmt.Errorf("can not read the security token of profile %s from: '%s', Error: %q", p.profile, p.securityTokenFile, err)
+	}
+	token := strings.TrimSpace(string(content))
+
+	claims, err := parseSecurityTokenClaims(token)
+	if err != nil {
+		return "", fmt.Errorf("the security token of profile %s in '%s' is not valid: %v", p.profile, p.securityTokenFile, err)
+	}
+
+	if exp, ok := claims["exp"].(float64); ok && time.Unix(int64(exp), 0).Before(time.Now()) {
+		return "", fmt.Errorf("the security token of profile %s expired at %s, refresh it with `oci session refresh --profile %s`", p.profile, time.Unix(int64(exp), 0).UTC().Format(time.RFC3339), p.profile)
+	}
+
+	return securityTokenKeyIdPrefix + token, nil
+}
+
+func (p securityTokenConfigurationProvider) TenancyOCID() (string, error) {
+	if p.tenancyOCID == "" {
+		return "", fmt.Errorf("profile %s of the configuration file does not contain tenancy", p.profile)
+	}
+	return p.tenancyOCID, nil
+}
+
+func (p securityTokenConfigurationProvider) UserOCID() (string, error) {
+	return "", nil
+}
+
+func (p securityTokenConfigurationProvider) KeyFingerprint() (string, error) {
+	return "", nil
+}
+
+func (p securityTokenConfigurationProvider) Region() (string, error) {
+	if p.region == "" {
+		return "", fmt.Errorf("profile %s of the configuration file does not contain region", p.profile)
+	}
+	return p.region, nil
+}
+
+// resourcePrincipalConfigurationProvider signs requests with the resource principal session token (RPST) and key
+// given to the resource through the OCI_RESOURCE_PRINCIPAL_* environment variables, e.g. in Functions.
+// The token and key can be given as their values or as absolute paths to the files that contain them.
+type resourcePrincipalConfigurationProvider struct {
+	rpst                 string
+	privatePem           string
+	privatePemPassphrase string
+	region               string
+}
+
+func newResourcePrincipalConfigurationProvider(region string) (oci_common.ConfigurationProvider, error) {
+	version := os.Getenv(resourcePrincipalVersionEnv)
+	if version == "" {
+		return nil, fmt.Errorf("can not get %s from the environment (ResourcePrincipal)", resourcePrincipalVersionEnv)
+	}
+	if version != resourcePrincipalVersion2_2 {
+		return nil, fmt.Errorf("resource principal version %s is not supported, %s must be %s", version, resourcePrincipalVersionEnv, resourcePrincipalVersion2_2)
+	}
+
+	provider := resourcePrincipalConfigurationProvider{
+		rpst:                 os.Getenv(resourcePrincipalRpstEnv),
+		privatePem:           os.Getenv(resourcePrincipalPrivatePemEnv),
+		privatePemPassphrase: os.Getenv(resourcePrincipalPrivatePemPassphraseEnv),
+		region:               region,
+	}
+
+	if provider.rpst == "" {
+		return nil, fmt.Errorf("can not get %s from the environment (ResourcePrincipal)", resourcePrincipalRpstEnv)
+	}
+	if provider.privatePem == "" {
+		return nil, fmt.Errorf("can not get %s from the environment (ResourcePrincipal)", resourcePrincipalPrivatePemEnv)
+	}
+	if provider.region == "" {
+		provider.region = os.Getenv(resourcePrincipalRegionEnv)
+	}
+	if provider.region == "" {
+		return nil, fmt.Errorf("can not get %s from the environment or %s from Terraform configuration (ResourcePrincipal)", resourcePrincipalRegionEnv, regionAttrName)
+	}
+
+	if _, err := provider.TenancyOCID(); err != nil {
+		return nil, err
+	}
+	if _, err := provider.PrivateRSAKey(); err != nil {
+		return nil, err
+	}
+
+	return provider, nil
+}
+
+// The token and key are read on every call when they are given as paths, the files are refreshed by the resource
+func (p resourcePrincipalConfigurationProvider) PrivateRSAKey() (*rsa.PrivateKey, error) {
+	pem, err := readValueOrFile(p.privatePem, resourcePrincipalPrivatePemEnv)
+	if err != nil {
+		return nil, err
+	}
+
+	var passphrase *string
+	if p.privatePemPassphrase != "" {
+		value, err := readValueOrFile(p.privatePemPassphrase, resourcePrincipalPrivatePemPassphraseEnv)
+		if err != nil {
+			return nil, err
+		}
+		passphrase = &value
+	}
+
+	key, err := oci_common.PrivateKeyFromBytes([]byte(pem), passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("can not parse the private key of %s: %v", resourcePrincipalPrivatePemEnv, err)
+	}
+	return key, nil
+}
+
+func (p resourcePrincipalConfigurationProvider) KeyID() (string, error) {
+	rpst, err := readValueOrFile(p.rpst, resourcePrincipalRpstEnv)
+	if err != nil {
+		return "", err
+	}
+	return securityTokenKeyIdPrefix + rpst, nil
+}
+
+// TenancyOCID is the tenancy of the resource, it is a claim of the token
+func (p resourcePrincipalConfigurationProvider) TenancyOCID() (string, error) {
+	rpst, err := readValueOrFile(p.rpst, resourcePrincipalRpstEnv)
+	if err != nil {
+		return "", err
+	}
+
+	claims, err := parseSecurityTokenClaims(rpst)
+	if err != nil {
+		return "", fmt.Errorf("the token of %s is not valid: %v", resourcePrincipalRpstEnv, err)
+	}
+
+	tenancyOCID, ok := claims["res_tenant"].(string)
+	if !ok || tenancyOCID == "" {
+		return "", fmt.Errorf("the token of %s does not contain the res_tenant claim", resourcePrincipalRpstEnv)
+	}
+	return tenancyOCID, nil
+}
+
+func (p resourcePrincipalConfigurationProvider) UserOCID() (string, error) {
+	return "", nil
+}
+
+func (p resourcePrincipalConfigurationProvider) KeyFingerprint() (string, error) {
+	return "", nil
+}
+
+func (p resourcePrincipalConfigurationProvider) Region() (string, error) {
+	return p.region, nil
+}
+
+// readValueOrFile returns the content of the file if value is an absolute path, or value itself otherwise
+func readValueOrFile(value string, name string) (string, error) {
+	if !filepath.IsAbs(value) {
+		return value, nil
+	}
+
+	content, err := ioutil.ReadFile(value)
+	if err != nil {
+		return "", fmt.Errorf("can not read %s from: '%s', Error: %q", name, value, err)
+	}
+	return strings.TrimSpace(string(content)), nil
+}
+
+// parseSecurityTokenClaims returns the claims of a JWT, the signature is not verified
+func parseSecurityTokenClaims(token string) (map[string]interface{}, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("expected a JWT with 3 parts, got %d", len(parts))
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return nil, fmt.Errorf("can not decode the claims: %v", err)
+	}
+
+	claims := map[string]interface{}{}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, fmt.Errorf("can not parse the claims: %v", err)
+	}
+	return claims, nil
+}
+
+// readConfigFileProfile returns the key value pairs of a profile of an OCI config file
+func readConfigFileProfile(configFilePath string, profile string) (map[string]string, error) {
+	content, err := ioutil.ReadFile(expandPath(configFilePath))
+	if err != nil {
+		return nil, fmt.Errorf("can not read the configuration file '%s', Error: %q", configFilePath, err)
+	}
+
+	var values map[string]string
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			if values != nil {
+				break
+			}
+			if strings.TrimSpace(line[1:len(line)-1]) == profile {
+				values = map[string]string{}
+			}
+			continue
+		}
+
+		if values == nil {
+			continue
+		}
+
+		if index := strings.Index(line, "="); index > 0 {
+			values[strings.TrimSpace(line[:index])] = strings.TrimSpace(line[index+1:])
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("can not read the configuration file '%s', Error: %q", configFilePath, err)
+	}
+
+	if values == nil {
+		return nil, fmt.Errorf("the configuration file '%s' does not contain profile %s", configFilePath, profile)
+	}
+	return values, nil
+}
+
+func expandPath(path string) string {
+	if expanded, err := homedir.Expand(path); err == nil {
+		return expanded
+	}
+	return path
+}
+
+// configFileConfigurationProvider reads the API key configuration from a profile of the OCI config file,
+// its errors name the profile and the missing key
+type configFileConfigurationProvider struct {
+	configFilePath     string
+	profile            string
+	values             map[string]string
+	privateKeyPassword string
+}
+
+func newConfigFileConfigurationProvider(configFilePath string, profile string, privateKeyPassword string) (oci_common.ConfigurationProvider, error) {
+	values, err := readConfigFileProfile(configFilePath, profile)
+	if err != nil {
+		return nil, err
+	}
+
+	return configFileConfigurationProvider{
+		configFilePath:     configFilePath,
+		profile:            profile,
+		values:             values,
+		privateKeyPassword: privateKeyPassword,
+	}, nil
+}
+
+func (p configFileConfigurationProvider) get(key string) (string, error) {
+	if value := p.values[key]; value != "" {
+		return value, nil
+	}
+	return "", fmt.Errorf("profile %s of the configuration file %s does not contain %s", p.profile, p.configFilePath, key)
+}
+
+func (p configFileConfigurationProvider) TenancyOCID() (string, error) {
+	return p.get("tenancy")
+}
+
+func (p configFileConfigurationProvider) UserOCID() (string, error) {
+	return p.get("user")
+}
+
+func (p configFileConfigurationProvider) KeyFingerprint() (string, error) {
+	return p.get("fingerprint")
+}
+
+func (p configFileConfigurationProvider) Region() (string, error) {
+	return p.get("region")
+}
+
+func (p configFileConfigurationProvider) KeyID() (string, error) {
+	tenancy, err := p.TenancyOCID()
+	if err != nil {
+		return "", err
+	}
+
+	user, err := p.UserOCID()
+	if err != nil {
+		return "", err
+	}
+
+	fingerprint, err := p.KeyFingerprint()
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s/%s/%s", tenancy, user, fingerprint), nil
+}
+
+func (p configFileConfigurationProvider) PrivateRSAKey() (*rsa.PrivateKey, error) {
+	keyFile, err := p.get("key_file")
+	if err != nil {
+		return nil, err
+	}
+
+	pemFileContent, err := ioutil.ReadFile(expandPath(keyFile))
+	if err != nil {
+		return nil, fmt.Errorf("can not read the private key of profile %s from: '%s', Error: %q", p.profile, keyFile, err)
+	}
+
+	password := p.privateKeyPassword
+	if passphrase, ok := p.values["pass_phrase"]; ok {
+		password = passphrase
+	}
+
+	key, err := oci_common.PrivateKeyFromBytes(pemFileContent, &password)
+	if err != nil {
+		return nil, fmt.Errorf("can not parse the private key of profile %s from: '%s', Error: %q", p.profile, keyFile, err)
+	}
+	return key, nil
+}
+
+// composingConfigurationProvider returns the value of the first provider that has it like the composing provider of the SDK,
+// but when none has it the errors of all the providers are returned instead of being swallowed
+type composingConfigurationProvider []oci_common.ConfigurationProvider
+
+func newComposingConfigurationProvider(providers []oci_common.ConfigurationProvider) (oci_common.ConfigurationProvider, error) {
+	if len(providers) == 0 {
+		return nil, fmt.Errorf("providers can not be an empty slice")
+	}
+
+	for i, p := range providers {
+		if p == nil {
+			return nil, fmt.Errorf("provider in position: %d is nil", i)
+		}
+	}
+	return composingConfigurationProvider(providers), nil
+}
+
+func (c composingConfigurationProvider) getString(name string, get func(p oci_common.ConfigurationProvider) (string, error)) (string, error) {
+	var errs []string
+	for _, p := range c {
+		value, err := get(p)
+		if err == nil {
+			return value, nil
+		}
+		errs = append(errs, err.Error())
+	}
+	return "", fmt.Errorf("did not find a proper configuration for %s: %s", name, strings.Join(errs, "; "))
+}
+
+func (c composingConfigurationProvider) TenancyOCID() (string, error) {
+	return c.getString(tenancyOcidAttrName, oci_common.ConfigurationProvider.TenancyOCID)
+}
+
+func (c composingConfigurationProvider) UserOCID() (string, error) {
+	return c.getString(userOcidAttrName, oci_common.ConfigurationProvider.UserOCID)
+}
+
+func (c composingConfigurationProvider) KeyFingerprint() (string, error) {
+	return c.getString(fingerprintAttrName, oci_common.ConfigurationProvider.KeyFingerprint)
+}
+
+func (c composingConfigurationProvider) Region() (string, error) {
+	return c.getString(regionAttrName, oci_common.ConfigurationProvider.Region)
+}
+
+func (c composingConfigurationProvider) KeyID() (string, error) {
+	return c.getString("key id", oci_common.ConfigurationProvider.KeyID)
+}
+
+func (c composingConfigurationProvider) PrivateRSAKey() (*rsa.PrivateKey, error) {
+	var errs []string
+	for _, p := range c {
+		key, err := p.PrivateRSAKey()
+		if err == nil {
+			return key, nil
+		}
+		errs = append(errs, err.Error())
+	}
+	return nil, fmt.Errorf("did not find a proper configuration for private key: %s", strings.Join(errs, "; "))
+}
@@ -0,0 +1,391 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/stretchr/testify/assert"
+
+	oci_common "github.com/oracle/oci-go-sdk/common"
+
+	"github.com/terraform-providers/terraform-provider-oci/httpreplay"
+)
+
+func testSecurityToken(t *testing.T, claims map[string]interface{}) string {
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"RS256"}`)) + "." + base64.RawURLEncoding.EncodeToString(payload) + ".signature"
+}
+
+func writeTestFile(t *testing.T, dir string, name string, content string) string {
+	path := filepath.Join(dir, name)
+	if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestUnitSecurityTokenConfigurationProvider(t *testing.T) {
+	dir, err := ioutil.TempDir("", "security-token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	token := testSecurityToken(t, map[string]interface{}{"exp": time.Now().Add(time.Hour).Unix()})
+	tokenFile := writeTestFile(t, dir, "token", token+"\n")
+	keyFile := writeTestFile(t, dir, "oci_api_key.pem", testPrivateKey)
+	configFile := writeTestFile(t, dir, "config", fmt.Sprintf(`[DEFAULT]
+user=%s
+fingerprint=%s
+key_file=%s
+tenancy=%s
+region=us-ashburn-1
+
+# created by oci session authenticate
+[session]
+fingerprint = %s
+key_file = %s
+pass_phrase = password
+tenancy = %s
+region = us-phoenix-1
+security_token_file = %s
+`, testUserOCID, testKeyFingerPrint, keyFile, testTenancyOCID, testKeyFingerPrint, keyFile, testTenancyOCID, tokenFile))
+
+	provider, err := newSecurityTokenConfigurationProvider(configFile, "session", "")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	keyId, err := provider.KeyID()
+	assert.NoError(t, err)
+	assert.Equal(t, "ST$"+token, keyId)
+
+	tenancyOCID, err := provider.TenancyOCID()
+	assert.NoError(t, err)
+	assert.Equal(t, testTenancyOCID, tenancyOCID)
+
+	region, err := provider.Region()
+	assert.NoError(t, err)
+	assert.Equal(t, "us-phoenix-1", region)
+
+	key, err := provider.PrivateRSAKey()
+	assert.NoError(t, err)
+	assert.NotNil(t, key)
+
+	// The region of the provider takes precedence over the one of the profile
+	provider, err = newSecurityTokenConfigurationProvider(configFile, "session", "eu-frankfurt-1")
+	assert.NoError(t, err)
+	region, _ = provider.Region()
+	assert.Equal(t, "eu-frankfurt-1", region)
+
+	// A refreshed token is picked up
+	refreshedToken := testSecurityToken(t, map[string]interface{}{"exp": time.Now().Add(2 * time.Hour).Unix()})
+	writeTestFile(t, dir, "token", refreshedToken)
+	keyId, err = provider.KeyID()
+	assert.NoError(t, err)
+	assert.Equal(t, "ST$"+refreshedToken, keyId)
+
+	writeTestFile(t, dir, "token", testSecurityToken(t, map[string]interface{}{"exp": time.Now().Add(-time.Hour).Unix()}))
+	_, err = provider.KeyID()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "oci session refresh --profile session")
+
+	_, err = newSecurityTokenConfigurationProvider(configFile, "session", "")
+	assert.Error(t, err)
+
+	_, err = newSecurityTokenConfigurationProvider(configFile, "DEFAULT", "")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "profile DEFAULT")
+	assert.Contains(t, err.Error(), "security_token_file")
+
+	_, err = newSecurityTokenConfigurationProvider(configFile, "missing", "")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "does not contain profile missing")
+}
+
+func setResourcePrincipalEnv(values map[string]string) func() {
+	names := []string{resourcePrincipalVersionEnv, resourcePrincipalRpstEnv, resourcePrincipalPrivatePemEnv, resourcePrincipalPrivatePemPassphraseEnv, resourcePrincipalRegionEnv}
+	previous := map[string]string{}
+	for _, name := range names {
+		previous[name] = os.Getenv(name)
+		os.Setenv(name, values[name])
+	}
+
+	return func() {
+		for name, value := range previous {
+			os.Setenv(name, value)
+		}
+	}
+}
+
+func TestUnitResourcePrincipalConfigurationProvider(t *testing.T) {
+	dir, err := ioutil.TempDir("", "resource-principal")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	rpst := testSecurityToken(t, map[string]interface{}{"res_tenant": testTenancyOCID})
+	rpstFile := writeTestFile(t, dir, "rpst", rpst)
+	pemFile := writeTestFile(t, dir, "private.pem", testPrivateKey)
+	passphraseFile := writeTestFile(t, dir, "passphrase", "password")
+
+	env := map[string]string{
+		resourcePrincipalVersionEnv:              "2.2",
+		resourcePrincipalRpstEnv:                 rpstFile,
+		resourcePrincipalPrivatePemEnv:           pemFile,
+		resourcePrincipalPrivatePemPassphraseEnv: passphraseFile,
+		resourcePrincipalRegionEnv:               "us-phoenix-1",
+	}
+	restore := setResourcePrincipalEnv(env)
+	defer restore()
+
+	provider, err := newResourcePrincipalConfigurationProvider("")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	keyId, err := provider.KeyID()
+	assert.NoError(t, err)
+	assert.Equal(t, "ST$"+rpst, keyId)
+
+	tenancyOCID, err := provider.TenancyOCID()
+	assert.NoError(t, err)
+	assert.Equal(t, testTenancyOCID, tenancyOCID)
+
+	region, err := provider.Region()
+	assert.NoError(t, err)
+	assert.Equal(t, "us-phoenix-1", region)
+
+	key, err := provider.PrivateRSAKey()
+	assert.NoError(t, err)
+	assert.NotNil(t, key)
+
+	// The token and key can also be given as values
+	env[resourcePrincipalRpstEnv] = rpst
+	env[resourcePrincipalPrivatePemEnv] = testPrivateKey
+	env[resourcePrincipalPrivatePemPassphraseEnv] = "password"
+	setResourcePrincipalEnv(env)
+
+	provider, err = newResourcePrincipalConfigurationProvider("eu-frankfurt-1")
+	if !assert.NoError(t, err) {
+		return
+	}
+	keyId, _ = provider.KeyID()
+	assert.Equal(t, "ST$"+rpst, keyId)
+	region, _ = provider.Region()
+	assert.Equal(t, "eu-frankfurt-1", region)
+
+	env[resourcePrincipalVersionEnv] = "1.1"
+	setResourcePrincipalEnv(env)
+	_, err = newResourcePrincipalConfigurationProvider("")
+	assert.Error(t, err)
+
+	env[resourcePrincipalVersionEnv] = "2.2"
+	env[resourcePrincipalRpstEnv] = testSecurityToken(t, map[string]interface{}{})
+	setResourcePrincipalEnv(env)
+	_, err = newResourcePrincipalConfigurationProvider("")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "res_tenant")
+
+	env[resourcePrincipalRpstEnv] = ""
+	setResourcePrincipalEnv(env)
+	_, err = newResourcePrincipalConfigurationProvider("")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), resourcePrincipalRpstEnv)
+}
+
+func TestUnitGetConfigProviders_tokenAuthWithApiKeyAttributes(t *testing.T) {
+	for _, apiKeyConfigAttribute := range apiKeyConfigAttributes {
+		if getEnvSettingWithBlankDefault(apiKeyConfigAttribute) != "" {
+			t.Skip("apiKeyConfigAttributes are set through environment variables, skip the test")
+		}
+	}
+
+	for _, auth := range []string{authSecurityTokenSetting, authResourcePrincipalSetting} {
+		r := &schema.Resource{
+			Schema: schemaMap(),
+		}
+		d := r.Data(nil)
+		d.Set("auth", auth)
+		d.Set("region", "us-phoenix-1")
+		d.Set("user_ocid", testUserOCID)
+		d.Set("fingerprint", testKeyFingerPrint)
+
+		_, err := getConfigProviders(d, strings.ToLower(auth))
+		assert.Error(t, err, auth)
+		assert.Equal(t, "user credentials user_ocid, fingerprint should be removed from the configuration", err.Error(), auth)
+	}
+}
+
+func writeTestConfigFile(t *testing.T, dir string) string {
+	keyFile := writeTestFile(t, dir, "oci_api_key.pem", testPrivateKey)
+	return writeTestFile(t, dir, "config", fmt.Sprintf(`[DEFAULT]
+user=ocid1.user.oc1..defaultuser
+fingerprint=%s
+key_file=%s
+pass_phrase=password
+tenancy=ocid1.tenancy.oc1..defaulttenancy
+region=us-ashburn-1
+
+[tenancy2]
+user=%s
+fingerprint=%s
+key_file=%s
+pass_phrase=password
+tenancy=%s
+region=us-phoenix-1
+
+[nofingerprint]
+user=%s
+key_file=%s
+tenancy=%s
+region=us-phoenix-1
+`, testKeyFingerPrint, keyFile, testUserOCID, testKeyFingerPrint, keyFile, testTenancyOCID, testUserOCID, keyFile, testTenancyOCID))
+}
+
+func TestUnitConfigFileConfigurationProvider(t *testing.T) {
+	dir, err := ioutil.TempDir("", "config-file")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	configFile := writeTestConfigFile(t, dir)
+
+	provider, err := newConfigFileConfigurationProvider(configFile, "tenancy2", "")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	tenancyOCID, _ := provider.TenancyOCID()
+	assert.Equal(t, testTenancyOCID, tenancyOCID)
+	userOCID, _ := provider.UserOCID()
+	assert.Equal(t, testUserOCID, userOCID)
+	region, _ := provider.Region()
+	assert.Equal(t, "us-phoenix-1", region)
+	keyId, err := provider.KeyID()
+	assert.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf("%s/%s/%s", testTenancyOCID, testUserOCID, testKeyFingerPrint), keyId)
+	key, err := provider.PrivateRSAKey()
+	assert.NoError(t, err)
+	assert.NotNil(t, key)
+
+	// The password of the provider is used if the profile has no pass_phrase
+	provider, err = newConfigFileConfigurationProvider(configFile, "nofingerprint", "password")
+	assert.NoError(t, err)
+	key, err = provider.PrivateRSAKey()
+	assert.NoError(t, err)
+	assert.NotNil(t, key)
+
+	_, err = provider.KeyFingerprint()
+	assert.EqualError(t, err, fmt.Sprintf("profile nofingerprint of the configuration file %s does not contain fingerprint", configFile))
+	_, err = provider.KeyID()
+	assert.Error(t, err)
+
+	_, err = newConfigFileConfigurationProvider(configFile, "missing", "")
+	assert.EqualError(t, err, fmt.Sprintf("the configuration file '%s' does not contain profile missing", configFile))
+
+	_, err = newConfigFileConfigurationProvider(filepath.Join(dir, "missing"), "DEFAULT", "")
+	assert.Error(t, err)
+}
+
+func TestUnitComposingConfigurationProvider(t *testing.T) {
+	dir, err := ioutil.TempDir("", "config-file")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	configFile := writeTestConfigFile(t, dir)
+
+	r := &schema.Resource{
+		Schema: schemaMap(),
+	}
+	d := r.Data(nil)
+	d.Set("region", "eu-frankfurt-1")
+
+	configFileProvider, err := newConfigFileConfigurationProvider(configFile, "nofingerprint", "")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	provider, err := newComposingConfigurationProvider([]oci_common.ConfigurationProvider{ResourceDataConfigProvider{d}, configFileProvider})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	// The first provider that has the value is used
+	region, _ := provider.Region()
+	assert.Equal(t, "eu-frankfurt-1", region)
+	tenancyOCID, _ := provider.TenancyOCID()
+	assert.Equal(t, testTenancyOCID, tenancyOCID)
+
+	// The errors of all the providers are reported
+	_, err = provider.KeyFingerprint()
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "can not get fingerprint from Terraform configuration")
+		assert.Contains(t, err.Error(), "profile nofingerprint of the configuration file")
+		assert.Contains(t, err.Error(), "does not contain fingerprint")
+	}
+
+	_, err = newComposingConfigurationProvider(nil)
+	assert.Error(t, err)
+}
+
+func TestUnitProviderConfig_configFileProfile(t *testing.T) {
+	if httpreplay.ModeRecordReplay() {
+		t.Skip("Skip TestUnitProviderConfig_configFileProfile in HttpReplay mode.")
+	}
+
+	dir, err := ioutil.TempDir("", "config-file")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	configFile := writeTestConfigFile(t, dir)
+
+	providerConfig := func(profile string) (*OracleClients, error) {
+		r := &schema.Resource{
+			Schema: schemaMap(),
+		}
+		d := r.Data(nil)
+		d.Set("auth", authAPIKeySetting)
+		d.Set("config_file_path", configFile)
+		d.Set("config_file_profile", profile)
+
+		client, err := ProviderConfig(d)
+		if err != nil {
+			return nil, err
+		}
+		return client.(*OracleClients), nil
+	}
+
+	clients, err := providerConfig("tenancy2")
+	if !assert.NoError(t, err) {
+		return
+	}
+	tenancyOCID, _ := (*clients.identityClient.ConfigurationProvider()).TenancyOCID()
+	assert.Equal(t, testTenancyOCID, tenancyOCID)
+	assert.True(t, strings.Contains(clients.identityClient.Host, "us-phoenix-1"), "unexpected host %s", clients.identityClient.Host)
+
+	_, err = providerConfig("nofingerprint")
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "profile nofingerprint of the configuration file")
+		assert.Contains(t, err.Error(), "does not contain fingerprint")
+	}
+
+	_, err = providerConfig("missing")
+	assert.EqualError(t, err, fmt.Sprintf("the configuration file '%s' does not contain profile missing", configFile))
+}
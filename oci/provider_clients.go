@@ -26,10 +26,15 @@ import (
 	oci_waas "github.com/oracle/oci-go-sdk/waas"
 
 	oci_common "github.com/oracle/oci-go-sdk/common"
+
+	"github.com/terraform-providers/terraform-provider-oci/metrics"
 )
 
 type OracleClients struct {
 	configuration                  map[string]string
+	configureClient                ConfigureClient
+	metricsRecorder                *metrics.Recorder
+	regionalClients                *regionalClientsCache
 	auditClient                    *oci_audit.AuditClient
 	autoScalingClient              *oci_auto_scaling.AutoScalingClient
 	blockstorageClient             *oci_core.BlockstorageClient
@@ -51,6 +56,7 @@ type OracleClients struct {
 	kmsVaultClient                 *oci_kms.KmsVaultClient
 	loadBalancerClient             *oci_load_balancer.LoadBalancerClient
 	monitoringClient               *oci_monitoring.MonitoringClient
+	monitoringIngestionClient      *oci_monitoring.MonitoringClient
 	notificationControlPlaneClient *oci_ons.NotificationControlPlaneClient
 	notificationDataPlaneClient    *oci_ons.NotificationDataPlaneClient
 	objectStorageClient            *oci_object_storage.ObjectStorageClient
@@ -62,7 +68,7 @@ type OracleClients struct {
 
 func (m *OracleClients) FunctionsInvokeClient(endpoint string) (*oci_functions.FunctionsInvokeClient, error) {
 	if client, err := oci_functions.NewFunctionsInvokeClientWithConfigurationProvider(*m.functionsInvokeClient.ConfigurationProvider(), endpoint); err == nil {
-		if err = configureClient(&client.BaseClient); err != nil {
+		if err = m.configureClient(&client.BaseClient); err != nil {
 			return nil, err
 		}
 		return &client, nil
@@ -73,7 +79,7 @@ func (m *OracleClients) FunctionsInvokeClient(endpoint string) (*oci_functions.F
 
 func (m *OracleClients) KmsCryptoClient(endpoint string) (*oci_kms.KmsCryptoClient, error) {
 	if client, err := oci_kms.NewKmsCryptoClientWithConfigurationProvider(*m.kmsCryptoClient.ConfigurationProvider(), endpoint); err == nil {
-		if err = configureClient(&client.BaseClient); err != nil {
+		if err = m.configureClient(&client.BaseClient); err != nil {
 			return nil, err
 		}
 		return &client, nil
@@ -84,9 +90,21 @@ func (m *OracleClients) KmsCryptoClient(endpoint string) (*oci_kms.KmsCryptoClie
 
 func (m *OracleClients) KmsManagementClient(endpoint string) (*oci_kms.KmsManagementClient, error) {
 	if client, err := oci_kms.NewKmsManagementClientWithConfigurationProvider(*m.kmsManagementClient.ConfigurationProvider(), endpoint); err == nil {
-		if err = configureClient(&client.BaseClient); err != nil {
+		if err = m.configureClient(&client.BaseClient); err != nil {
+			return nil, err
+		}
+		return &client, nil
+	} else {
+		return nil, err
+	}
+}
+
+func (m *OracleClients) StreamClient(endpoint string) (*oci_streaming.StreamClient, error) {
+	if client, err := oci_streaming.NewStreamClientWithConfigurationProvider(*m.streamAdminClient.ConfigurationProvider()); err == nil {
+		if err = m.configureClient(&client.BaseClient); err != nil {
 			return nil, err
 		}
+		client.Host = endpoint
 		return &client, nil
 	} else {
 		return nil, err
@@ -94,6 +112,8 @@ func (m *OracleClients) KmsManagementClient(endpoint string) (*oci_kms.KmsManage
 }
 
 func createSDKClients(clients *OracleClients, configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient) (err error) {
+	// the clients of the service endpoints that are only known when they are used, e.g. KMS vaults, are configured later on
+	clients.configureClient = configureClient
 
 	auditClient, err := oci_audit.NewAuditClientWithConfigurationProvider(configProvider)
 	if err != nil {
@@ -305,6 +325,22 @@ func createSDKClients(clients *OracleClients, configProvider oci_common.Configur
 	}
 	clients.monitoringClient = &monitoringClient
 
+	monitoringIngestionClient, err := oci_monitoring.NewMonitoringClientWithConfigurationProvider(configProvider)
+	if err != nil {
+		return
+	}
+	// Metric data is posted to the ingestion endpoint instead of the endpoint used to read metrics and manage alarms
+	region, err := configProvider.Region()
+	if err != nil {
+		return
+	}
+	monitoringIngestionClient.Host = oci_common.StringToRegion(region).EndpointForTemplate("telemetry-ingestion", "https://telemetry-ingestion.{region}.{secondLevelDomain}")
+	err = configureClient(&monitoringIngestionClient.BaseClient)
+	if err != nil {
+		return
+	}
+	clients.monitoringIngestionClient = &monitoringIngestionClient
+
 	notificationControlPlaneClient, err := oci_ons.NewNotificationControlPlaneClientWithConfigurationProvider(configProvider)
 	if err != nil {
 		return
@@ -0,0 +1,231 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"sync"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	oci_common "github.com/oracle/oci-go-sdk/common"
+)
+
+// The region of an imported resource can be given as a suffix of its ID, e.g. ocid1.vcn.oc1..xxx@eu-frankfurt-1
+var regionImportIdRegex = regexp.MustCompile(`^(.+)@([a-z]+(?:-[a-z]+)+-[0-9]+)$`)
+
+// regionalConfigurationProvider is the configuration of the provider with a different region
+type regionalConfigurationProvider struct {
+	oci_common.ConfigurationProvider
+	region string
+}
+
+func (p regionalConfigurationProvider) Region() (string, error) {
+	return p.region, nil
+}
+
+// regionalClientsCache holds the clients of the regions that resources and data sources are placed in with
+// the `region` argument, they are created on first use and shared by all the resources of that region
+type regionalClientsCache struct {
+	sync.Mutex
+	providerClients *OracleClients
+	configProvider  oci_common.ConfigurationProvider
+	configureClient ConfigureClient
+	clients         map[string]*OracleClients
+}
+
+func newRegionalClientsCache(providerClients *OracleClients, configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient) *regionalClientsCache {
+	return &regionalClientsCache{
+		providerClients: providerClients,
+		configProvider:  configProvider,
+		configureClient: configureClient,
+		clients:         map[string]*OracleClients{},
+	}
+}
+
+// ForRegion returns the clients for the given region, the clients of the provider are returned for its own region
+func (m *OracleClients) ForRegion(region string) (*OracleClients, error) {
+	if m.regionalClients == nil {
+		return nil, fmt.Errorf("cannot create clients for region %s, the provider is not configured", region)
+	}
+	cache := m.regionalClients
+
+	region = string(oci_common.StringToRegion(region))
+	if providerRegion, err := cache.configProvider.Region(); err == nil && string(oci_common.StringToRegion(providerRegion)) == region {
+		return cache.providerClients, nil
+	}
+
+	cache.Lock()
+	defer cache.Unlock()
+
+	if clients, ok := cache.clients[region]; ok {
+		return clients, nil
+	}
+
+	clients := &OracleClients{configuration: m.configuration, metricsRecorder: cache.providerClients.metricsRecorder, regionalClients: cache}
+	err := createSDKClients(clients, regionalConfigurationProvider{cache.configProvider, region}, cache.configureClient)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create clients for region %s: %v", region, err)
+	}
+
+	cache.clients[region] = clients
+	return clients, nil
+}
+
+type regionGetter interface {
+	GetOk(key string) (interface{}, bool)
+}
+
+// clientsForRegion returns the clients for the `region` of a resource or data source, or the clients of the provider if it is not set
+func clientsForRegion(d regionGetter, m interface{}) (interface{}, error) {
+	region, ok := d.GetOk(regionAttrName)
+	if !ok {
+		return m, nil
+	}
+
+	clients, ok := m.(*OracleClients)
+	if !ok {
+		return m, nil
+	}
+
+	return clients.ForRegion(region.(string))
+}
+
+func parseRegionImportId(importId string) (id string, region string, ok bool) {
+	matches := regionImportIdRegex.FindStringSubmatch(importId)
+	if matches == nil {
+		return importId, "", false
+	}
+
+	return matches[1], matches[2], true
+}
+
+// addResourcesRegionOverride adds the `region` argument to the resources, changing it recreates the resource in the new region.
+// Resources that already have a computed `region` attribute, e.g. oci_core_instance, get it as an argument as well, the
+// region read from the service is then the region the resource is managed in.
+func addResourcesRegionOverride(resources map[string]*schema.Resource) map[string]*schema.Resource {
+	for _, resource := range resources {
+		if region, exists := resource.Schema[regionAttrName]; exists {
+			region.Optional = true
+			region.Computed = true
+			region.ForceNew = true
+			region.DiffSuppressFunc = regionDiffSuppressFunction
+		} else {
+			resource.Schema[regionAttrName] = &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: regionDiffSuppressFunction,
+			}
+		}
+		addRegionOverride(resource)
+	}
+
+	return resources
+}
+
+// addDataSourcesRegionOverride adds the `region` argument to the data sources, the data sources that already have a
+// computed `region` attribute get it as an argument as well
+func addDataSourcesRegionOverride(dataSources map[string]*schema.Resource) map[string]*schema.Resource {
+	for _, dataSource := range dataSources {
+		if region, exists := dataSource.Schema[regionAttrName]; exists {
+			region.Optional = true
+			region.Computed = true
+		} else {
+			dataSource.Schema[regionAttrName] = &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			}
+		}
+		addRegionOverride(dataSource)
+	}
+
+	return dataSources
+}
+
+// Regions are compared by name, the services return the short code of some regions, e.g. `phx` for `us-phoenix-1`
+func regionDiffSuppressFunction(key string, old string, new string, d *schema.ResourceData) bool {
+	return old != "" && new != "" && oci_common.StringToRegion(old) == oci_common.StringToRegion(new)
+}
+
+// addRegionOverride performs the operations of a resource or data source with the clients of its `region`
+func addRegionOverride(resource *schema.Resource) {
+	if createFn := resource.Create; createFn != nil {
+		resource.Create = func(d *schema.ResourceData, m interface{}) error {
+			m, err := clientsForRegion(d, m)
+			if err != nil {
+				return err
+			}
+			return createFn(d, m)
+		}
+	}
+
+	if readFn := resource.Read; readFn != nil {
+		resource.Read = func(d *schema.ResourceData, m interface{}) error {
+			m, err := clientsForRegion(d, m)
+			if err != nil {
+				return err
+			}
+			return readFn(d, m)
+		}
+	}
+
+	if updateFn := resource.Update; updateFn != nil {
+		resource.Update = func(d *schema.ResourceData, m interface{}) error {
+			m, err := clientsForRegion(d, m)
+			if err != nil {
+				return err
+			}
+			return updateFn(d, m)
+		}
+	}
+
+	if deleteFn := resource.Delete; deleteFn != nil {
+		resource.Delete = func(d *schema.ResourceData, m interface{}) error {
+			m, err := clientsForRegion(d, m)
+			if err != nil {
+				return err
+			}
+			return deleteFn(d, m)
+		}
+	}
+
+	if existsFn := resource.Exists; existsFn != nil {
+		resource.Exists = func(d *schema.ResourceData, m interface{}) (bool, error) {
+			m, err := clientsForRegion(d, m)
+			if err != nil {
+				return false, err
+			}
+			return existsFn(d, m)
+		}
+	}
+
+	if customizeDiffFn := resource.CustomizeDiff; customizeDiffFn != nil {
+		resource.CustomizeDiff = func(d *schema.ResourceDiff, m interface{}) error {
+			m, err := clientsForRegion(d, m)
+			if err != nil {
+				return err
+			}
+			return customizeDiffFn(d, m)
+		}
+	}
+
+	if resource.Importer != nil && resource.Importer.State != nil {
+		stateFn := resource.Importer.State
+		resource.Importer.State = func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+			if id, region, ok := parseRegionImportId(d.Id()); ok {
+				d.SetId(id)
+				if err := d.Set(regionAttrName, region); err != nil {
+					return nil, err
+				}
+			}
+
+			m, err := clientsForRegion(d, m)
+			if err != nil {
+				return nil, err
+			}
+			return stateFn(d, m)
+		}
+	}
+}
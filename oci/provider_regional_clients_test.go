@@ -0,0 +1,199 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/stretchr/testify/assert"
+
+	oci_common "github.com/oracle/oci-go-sdk/common"
+)
+
+func testRegionalClients(t *testing.T) *OracleClients {
+	password := "password"
+	configProvider := oci_common.NewRawConfigurationProvider(testTenancyOCID, testUserOCID, "us-phoenix-1", testKeyFingerPrint, testPrivateKey, &password)
+
+	configureClientFn := func(client *oci_common.BaseClient) error { return nil }
+
+	clients := &OracleClients{configuration: map[string]string{}}
+	if err := createSDKClients(clients, configProvider, configureClientFn); err != nil {
+		t.Fatalf("cannot create the clients: %v", err)
+	}
+	clients.regionalClients = newRegionalClientsCache(clients, configProvider, configureClientFn)
+
+	return clients
+}
+
+func TestUnitOracleClientsForRegion(t *testing.T) {
+	clients := testRegionalClients(t)
+
+	home, err := clients.ForRegion("us-phoenix-1")
+	assert.NoError(t, err)
+	assert.True(t, home == clients, "the clients of the provider should be used for its own region")
+
+	frankfurt, err := clients.ForRegion("eu-frankfurt-1")
+	assert.NoError(t, err)
+	assert.False(t, frankfurt == clients)
+	assert.True(t, strings.Contains(frankfurt.identityClient.Host, "eu-frankfurt-1"), "unexpected host %s", frankfurt.identityClient.Host)
+	assert.True(t, strings.Contains(frankfurt.objectStorageClient.Host, "eu-frankfurt-1"), "unexpected host %s", frankfurt.objectStorageClient.Host)
+	assert.True(t, strings.Contains(clients.identityClient.Host, "us-phoenix-1"), "the clients of the provider should not change, got host %s", clients.identityClient.Host)
+
+	cached, err := clients.ForRegion("fra")
+	assert.NoError(t, err)
+	assert.True(t, cached == frankfurt, "the clients of a region should be created once")
+
+	fromRegional, err := frankfurt.ForRegion("us-phoenix-1")
+	assert.NoError(t, err)
+	assert.True(t, fromRegional == clients, "the clients of the provider should be used for its own region")
+
+	_, err = (&OracleClients{}).ForRegion("eu-frankfurt-1")
+	assert.Error(t, err)
+}
+
+// The clients of the endpoints only known when they are used are configured by the provider that owns them, so that
+// provider aliases keep their own rate limiter and metrics recorder
+func TestUnitOracleClientsEndpointClients(t *testing.T) {
+	password := "password"
+	configProvider := oci_common.NewRawConfigurationProvider(testTenancyOCID, testUserOCID, "us-phoenix-1", testKeyFingerPrint, testPrivateKey, &password)
+
+	newClients := func(userAgent string) *OracleClients {
+		configureClientFn := func(client *oci_common.BaseClient) error {
+			client.UserAgent = userAgent
+			return nil
+		}
+
+		clients := &OracleClients{configuration: map[string]string{}}
+		if err := createSDKClients(clients, configProvider, configureClientFn); err != nil {
+			t.Fatalf("cannot create the clients: %v", err)
+		}
+		clients.regionalClients = newRegionalClientsCache(clients, configProvider, configureClientFn)
+		return clients
+	}
+
+	first := newClients("first")
+	newClients("second")
+	frankfurt, err := first.ForRegion("eu-frankfurt-1")
+	assert.NoError(t, err)
+
+	for _, clients := range []*OracleClients{first, frankfurt} {
+		functionsInvokeClient, err := clients.FunctionsInvokeClient("https://functions.example.com")
+		if assert.NoError(t, err) {
+			assert.Equal(t, "first", functionsInvokeClient.UserAgent)
+		}
+		kmsCryptoClient, err := clients.KmsCryptoClient("https://crypto.example.com")
+		if assert.NoError(t, err) {
+			assert.Equal(t, "first", kmsCryptoClient.UserAgent)
+		}
+		kmsManagementClient, err := clients.KmsManagementClient("https://management.example.com")
+		if assert.NoError(t, err) {
+			assert.Equal(t, "first", kmsManagementClient.UserAgent)
+		}
+		streamClient, err := clients.StreamClient("https://stream.example.com")
+		if assert.NoError(t, err) {
+			assert.Equal(t, "first", streamClient.UserAgent)
+		}
+	}
+}
+
+func TestUnitParseRegionImportId(t *testing.T) {
+	type testFormat struct {
+		importId string
+		id       string
+		region   string
+		ok       bool
+	}
+	tests := []testFormat{
+		{importId: "ocid1.vcn.oc1.phx.aaaa@eu-frankfurt-1", id: "ocid1.vcn.oc1.phx.aaaa", region: "eu-frankfurt-1", ok: true},
+		{importId: "ocid1.vcn.oc1.phx.aaaa@uk-gov-london-1", id: "ocid1.vcn.oc1.phx.aaaa", region: "uk-gov-london-1", ok: true},
+		{importId: "n/namespace/b/bucket/o/user@example.com@us-ashburn-1", id: "n/namespace/b/bucket/o/user@example.com", region: "us-ashburn-1", ok: true},
+		{importId: "ocid1.vcn.oc1.phx.aaaa", id: "ocid1.vcn.oc1.phx.aaaa", ok: false},
+		{importId: "n/namespace/b/bucket/o/user@example.com", id: "n/namespace/b/bucket/o/user@example.com", ok: false},
+		{importId: "@us-ashburn-1", id: "@us-ashburn-1", ok: false},
+	}
+
+	for _, test := range tests {
+		id, region, ok := parseRegionImportId(test.importId)
+		assert.Equal(t, test.id, id, test.importId)
+		assert.Equal(t, test.region, region, test.importId)
+		assert.Equal(t, test.ok, ok, test.importId)
+	}
+}
+
+func TestUnitAddRegionOverride(t *testing.T) {
+	clients := testRegionalClients(t)
+
+	var used *OracleClients
+	resource := &schema.Resource{
+		Read: func(d *schema.ResourceData, m interface{}) error {
+			used = m.(*OracleClients)
+			return nil
+		},
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				used = m.(*OracleClients)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Schema: map[string]*schema.Schema{
+			regionAttrName: {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+	addRegionOverride(resource)
+
+	d := resource.Data(nil)
+	assert.NoError(t, resource.Read(d, clients))
+	assert.True(t, used == clients, "the clients of the provider should be used when the region is not set")
+
+	d.Set(regionAttrName, "eu-frankfurt-1")
+	assert.NoError(t, resource.Read(d, clients))
+	assert.True(t, strings.Contains(used.identityClient.Host, "eu-frankfurt-1"), "unexpected host %s", used.identityClient.Host)
+
+	d = resource.Data(nil)
+	d.SetId("ocid1.vcn.oc1.fra.aaaa@eu-frankfurt-1")
+	_, err := resource.Importer.State(d, clients)
+	assert.NoError(t, err)
+	assert.Equal(t, "ocid1.vcn.oc1.fra.aaaa", d.Id())
+	assert.Equal(t, "eu-frankfurt-1", d.Get(regionAttrName))
+	assert.True(t, strings.Contains(used.identityClient.Host, "eu-frankfurt-1"), "unexpected host %s", used.identityClient.Host)
+}
+
+func TestUnitResourcesRegionOverride(t *testing.T) {
+	resources := resourcesMap()
+
+	region := resources["oci_core_vcn"].Schema[regionAttrName]
+	if assert.NotNil(t, region) {
+		assert.True(t, region.Optional)
+		assert.True(t, region.ForceNew)
+	}
+
+	// The computed region of a resource becomes an argument, short region codes are the same region
+	for _, name := range []string{"oci_core_instance", "oci_core_instance_configuration_instance", "oci_core_virtual_circuit"} {
+		region = resources[name].Schema[regionAttrName]
+		if assert.NotNil(t, region, name) {
+			assert.True(t, region.Optional, name)
+			assert.True(t, region.Computed, name)
+			assert.True(t, region.ForceNew, name)
+			assert.True(t, region.DiffSuppressFunc(regionAttrName, "phx", "us-phoenix-1", nil), name)
+			assert.False(t, region.DiffSuppressFunc(regionAttrName, "phx", "us-ashburn-1", nil), name)
+		}
+	}
+
+	region = dataSourcesMap()["oci_core_instance"].Schema[regionAttrName]
+	if assert.NotNil(t, region) {
+		assert.True(t, region.Optional)
+		assert.True(t, region.Computed)
+	}
+
+	region = dataSourcesMap()["oci_core_vcns"].Schema[regionAttrName]
+	if assert.NotNil(t, region) {
+		assert.True(t, region.Optional)
+		assert.False(t, region.ForceNew)
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Hosts of the OCI services are {service}.{region}.{domain}, or {prefix}.{service}.{region}.{domain} for e.g. KMS vaults
+var hostRegionLabelRegex = regexp.MustCompile(`^[a-z]+(?:-[a-z]+)+-[0-9]+$`)
+
+// tokenBucket allows rate requests per second on average, with bursts of up to burst requests
+type tokenBucket struct {
+	sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate float64) *tokenBucket {
+	burst := math.Max(1, math.Ceil(rate))
+	return &tokenBucket{
+		rate:   rate,
+		burst:  burst,
+		tokens: burst,
+		last:   time.Now(),
+	}
+}
+
+// reserve takes a token from the bucket and returns how long to wait before it can be used
+func (b *tokenBucket) reserve(now time.Time) time.Duration {
+	b.Lock()
+	defer b.Unlock()
+
+	if now.After(b.last) {
+		b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+		b.last = now
+	}
+
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// requestRateLimiter holds a bucket shared by the requests to all the services and a bucket per service,
+// a request waits for a token of both
+type requestRateLimiter struct {
+	all      *tokenBucket
+	services map[string]*tokenBucket
+}
+
+func newRequestRateLimiter(requestsPerSecond float64, serviceRequestsPerSecond map[string]float64) (*requestRateLimiter, error) {
+	limiter := &requestRateLimiter{services: map[string]*tokenBucket{}}
+
+	if requestsPerSecond < 0 {
+		return nil, fmt.Errorf("%s must not be negative, got %v", requestsPerSecondAttrName, requestsPerSecond)
+	}
+	if requestsPerSecond > 0 {
+		limiter.all = newTokenBucket(requestsPerSecond)
+	}
+
+	for service, rate := range serviceRequestsPerSecond {
+		if rate <= 0 {
+			return nil, fmt.Errorf("%s of service %s must be greater than 0, got %v", serviceRequestsPerSecondAttrName, service, rate)
+		}
+		limiter.services[strings.ToLower(service)] = newTokenBucket(rate)
+	}
+
+	if limiter.all == nil && len(limiter.services) == 0 {
+		return nil, nil
+	}
+	return limiter, nil
+}
+
+// wait blocks until the request can be sent, or until its context is done
+func (l *requestRateLimiter) wait(r *http.Request) error {
+	delay := time.Duration(0)
+	if l.all != nil {
+		delay = l.all.reserve(time.Now())
+	}
+
+	if bucket, ok := l.services[getServiceFromHost(r.URL.Hostname())]; ok {
+		if serviceDelay := bucket.reserve(time.Now()); serviceDelay > delay {
+			delay = serviceDelay
+		}
+	}
+
+	if delay == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-r.Context().Done():
+		return r.Context().Err()
+	}
+}
+
+// getServiceFromHost returns the service of an endpoint, e.g. iaas for iaas.us-phoenix-1.oraclecloud.com
+func getServiceFromHost(host string) string {
+	labels := strings.Split(strings.ToLower(host), ".")
+	for i := 1; i < len(labels); i++ {
+		if hostRegionLabelRegex.MatchString(labels[i]) {
+			return labels[i-1]
+		}
+	}
+	return labels[0]
+}
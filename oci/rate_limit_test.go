@@ -0,0 +1,127 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestUnitTokenBucket_reserve(t *testing.T) {
+	bucket := newTokenBucket(2)
+	now := bucket.last
+
+	// The bucket starts full, the burst is sent without waiting
+	for i := 0; i < 2; i++ {
+		if delay := bucket.reserve(now); delay != 0 {
+			t.Errorf("Expected request %d of the burst to not wait, got %v", i, delay)
+		}
+	}
+
+	if delay := bucket.reserve(now); delay != 500*time.Millisecond {
+		t.Errorf("Expected a wait of 500ms once the burst is used, got %v", delay)
+	}
+	if delay := bucket.reserve(now); delay != time.Second {
+		t.Errorf("Expected waiting requests to queue up, got %v", delay)
+	}
+
+	// Tokens are refilled at the rate, up to the burst
+	now = now.Add(10 * time.Second)
+	if delay := bucket.reserve(now); delay != 0 {
+		t.Errorf("Expected the bucket to be refilled, got %v", delay)
+	}
+	if bucket.tokens != 1 {
+		t.Errorf("Expected the bucket to be refilled up to the burst, got %v tokens left", bucket.tokens)
+	}
+
+	// A rate below 1 still allows a single request at a time
+	bucket = newTokenBucket(0.5)
+	if bucket.burst != 1 {
+		t.Errorf("Expected a burst of 1, got %v", bucket.burst)
+	}
+	bucket.reserve(bucket.last)
+	if delay := bucket.reserve(bucket.last); delay != 2*time.Second {
+		t.Errorf("Expected a wait of 2s, got %v", delay)
+	}
+}
+
+func TestUnitNewRequestRateLimiter(t *testing.T) {
+	limiter, err := newRequestRateLimiter(0, map[string]float64{})
+	if err != nil || limiter != nil {
+		t.Errorf("Expected no limiter when no rate is set, got %v, %v", limiter, err)
+	}
+
+	if _, err := newRequestRateLimiter(-1, nil); err == nil {
+		t.Errorf("Expected an error for a negative %s", requestsPerSecondAttrName)
+	}
+
+	if _, err := newRequestRateLimiter(0, map[string]float64{"iaas": 0}); err == nil {
+		t.Errorf("Expected an error for a %s of 0", serviceRequestsPerSecondAttrName)
+	}
+
+	limiter, err = newRequestRateLimiter(10, map[string]float64{"IaaS": 5})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if limiter.all == nil || limiter.all.rate != 10 {
+		t.Errorf("Expected a bucket of 10 requests per second for all the services")
+	}
+	if bucket, ok := limiter.services["iaas"]; !ok || bucket.rate != 5 {
+		t.Errorf("Expected a bucket of 5 requests per second for iaas, got %v", limiter.services)
+	}
+}
+
+func TestUnitRequestRateLimiter_wait(t *testing.T) {
+	limiter, err := newRequestRateLimiter(0, map[string]float64{"identity": 1})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	identityRequest, _ := http.NewRequest(http.MethodGet, "https://identity.us-phoenix-1.oraclecloud.com/20160918/users", nil)
+	coreRequest, _ := http.NewRequest(http.MethodGet, "https://iaas.us-phoenix-1.oraclecloud.com/20160918/vcns", nil)
+
+	if err := limiter.wait(identityRequest); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	// Other services are not limited
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		if err := limiter.wait(coreRequest); err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed > 100*time.Millisecond {
+		t.Errorf("Expected requests to iaas to not wait, took %v", elapsed)
+	}
+
+	// The next request to identity has to wait for a token, unless its context is done first
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := limiter.wait(identityRequest.WithContext(ctx)); err != context.DeadlineExceeded {
+		t.Errorf("Expected the wait to end with the context, got %v", err)
+	}
+}
+
+func TestUnitGetServiceFromHost(t *testing.T) {
+	type testCase struct {
+		host            string
+		expectedService string
+	}
+	testCases := []testCase{
+		{"iaas.us-phoenix-1.oraclecloud.com", "iaas"},
+		{"objectstorage.eu-frankfurt-1.oraclecloud.com", "objectstorage"},
+		{"xxxxx-management.kms.us-ashburn-1.oraclecloud.com", "kms"},
+		{"identity.ap-chuncheon-1.oraclecloud.com", "identity"},
+		{"Database.US-Phoenix-1.OracleCloud.com", "database"},
+		{"localhost", "localhost"},
+	}
+
+	for _, test := range testCases {
+		if actual := getServiceFromHost(test.host); actual != test.expectedService {
+			t.Errorf("Expected service %s for host %s, got %s", test.expectedService, test.host, actual)
+		}
+	}
+}
@@ -4,6 +4,8 @@ package provider
 
 import (
 	"math/rand"
+	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
@@ -59,6 +61,19 @@ func getRetryBackoffDurationWithExpectedRetryDurationFn(response oci_common.OCIO
 		return 0
 	}
 
+	// Wait as long as the service asked to, retrying earlier would only be throttled again. The wait doesn't go past
+	// the retry duration, so that the last attempt still happens before it expires.
+	if retryAfter, ok := getRetryAfterDuration(response); ok {
+		remainingRetryDuration := expectedRetryDurationFn(response, disableNotFoundRetries, service, optionals...) - getElapsedRetryDuration(startTime)
+		if retryAfter > remainingRetryDuration {
+			retryAfter = remainingRetryDuration
+		}
+		if retryAfter < minRetryBackoff {
+			retryAfter = minRetryBackoff
+		}
+		return retryAfter
+	}
+
 	// Avoid having a very large retry backoff
 	attempt := response.AttemptNumber
 	if attempt > quadraticBackoffCap {
@@ -83,6 +98,35 @@ func getRetryBackoffDurationWithExpectedRetryDurationFn(response oci_common.OCIO
 	return backoffDuration
 }
 
+// getRetryAfterDuration returns the wait requested by the service with the Retry-After or opc-retry-after headers,
+// given either in seconds or as an HTTP date
+func getRetryAfterDuration(response oci_common.OCIOperationResponse) (time.Duration, bool) {
+	if response.Response == nil || response.Response.HTTPResponse() == nil {
+		return 0, false
+	}
+
+	header := response.Response.HTTPResponse().Header
+	for _, name := range []string{"Retry-After", "opc-retry-after"} {
+		value := header.Get(name)
+		if values := header[strings.ToLower(name)]; value == "" && len(values) > 0 {
+			value = values[0]
+		}
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+
+		if seconds, err := strconv.ParseFloat(value, 64); err == nil && seconds >= 0 {
+			return time.Duration(seconds * float64(time.Second)), true
+		}
+		if date, err := http.ParseTime(value); err == nil {
+			return time.Until(date), true
+		}
+	}
+
+	return 0, false
+}
+
 func getElapsedRetryDuration(firstAttemptTime time.Time) time.Duration {
 	return time.Now().Sub(firstAttemptTime)
 }
@@ -209,7 +253,10 @@ func getObjectstorageServiceExpectedRetryDuration(response oci_common.OCIOperati
 	return defaultRetryTime
 }
 
+// shouldRetry is asked by every retry policy of the provider after every attempt of the operation, the metrics of the
+// call record its attempt
 func shouldRetry(response oci_common.OCIOperationResponse, disableNotFoundRetries bool, service string, startTime time.Time, optionals ...interface{}) bool {
+	claimApiCall(response)
 	return getElapsedRetryDuration(startTime) < getExpectedRetryDuration(response, disableNotFoundRetries, service, optionals...)
 }
 
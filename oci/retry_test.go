@@ -189,6 +189,59 @@ func TestUnitRetryKMSThrottling(t *testing.T) {
 	retryLoop(t, &r)
 }
 
+// The wait requested by the service with Retry-After or opc-retry-after should be used instead of the jittered backoff
+func TestUnitGetRetryBackoffDuration_retryAfter(t *testing.T) {
+	if httpreplay.ModeRecordReplay() {
+		t.Skip("Skip Retry Tests in HttpReplay mode.")
+	}
+	shortRetryTime = 2 * time.Minute
+	longRetryTime = 10 * time.Minute
+	configuredRetryDuration = nil
+
+	type testCase struct {
+		name             string
+		header           map[string][]string
+		expectedDuration time.Duration
+	}
+	testCases := []testCase{
+		{"seconds", map[string][]string{"Retry-After": {"5"}}, 5 * time.Second},
+		{"opc header", map[string][]string{"Opc-Retry-After": {"3"}}, 3 * time.Second},
+		{"lowercase key", map[string][]string{"retry-after": {"4"}}, 4 * time.Second},
+		{"below minimum", map[string][]string{"Retry-After": {"0"}}, minRetryBackoff},
+	}
+
+	for _, test := range testCases {
+		response := common.NewOCIOperationResponse(TestOCIResponse{statusCode: 429, header: test.header}, fmt.Errorf("Retriable error"), 1)
+		if actual := getRetryBackoffDuration(response, false, "core", time.Now()); actual != test.expectedDuration {
+			t.Errorf("%s: expected a backoff of %v, got %v", test.name, test.expectedDuration, actual)
+		}
+	}
+
+	date := time.Now().Add(30 * time.Second).UTC().Format(http.TimeFormat)
+	response := common.NewOCIOperationResponse(TestOCIResponse{statusCode: 503, header: map[string][]string{"Retry-After": {date}}}, fmt.Errorf("Retriable error"), 1)
+	if actual := getRetryBackoffDuration(response, false, "core", time.Now()); actual < 25*time.Second || actual > 30*time.Second {
+		t.Errorf("http date: expected a backoff of about 30s, got %v", actual)
+	}
+
+	// The wait is capped by what remains of the retry duration
+	response = common.NewOCIOperationResponse(TestOCIResponse{statusCode: 429, header: map[string][]string{"Retry-After": {"3600"}}}, fmt.Errorf("Retriable error"), 1)
+	startTime := time.Now().Add(time.Minute - getExpectedRetryDuration(response, false, "core"))
+	if actual := getRetryBackoffDuration(response, false, "core", startTime); actual < 55*time.Second || actual > time.Minute {
+		t.Errorf("beyond the retry duration: expected a backoff of about 1m, got %v", actual)
+	}
+
+	response = common.NewOCIOperationResponse(TestOCIResponse{statusCode: 429, header: map[string][]string{"Retry-After": {"3600"}}}, fmt.Errorf("Retriable error"), 1)
+	startTime = time.Now().Add(-getExpectedRetryDuration(response, false, "core"))
+	if actual := getRetryBackoffDuration(response, false, "core", startTime); actual != minRetryBackoff {
+		t.Errorf("after the retry duration: expected a backoff of %v, got %v", minRetryBackoff, actual)
+	}
+
+	response = common.NewOCIOperationResponse(TestOCIResponse{statusCode: 429, header: map[string][]string{"Retry-After": {"soon"}}}, fmt.Errorf("Retriable error"), 1)
+	if _, ok := getRetryAfterDuration(response); ok {
+		t.Errorf("invalid value: expected the header to be ignored")
+	}
+}
+
 func TestUnitRetrySubnet409Conflict(t *testing.T) {
 	if httpreplay.ModeRecordReplay() {
 		t.Skip("Skip Retry Tests in HttpReplay mode.")
@@ -0,0 +1,266 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	oci_common "github.com/oracle/oci-go-sdk/common"
+	oci_streaming "github.com/oracle/oci-go-sdk/streaming"
+)
+
+func StreamingMessagesDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: readStreamingMessagesDataSource,
+		Schema: map[string]*schema.Schema{
+			"messages_endpoint": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"stream_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"commit_on_get": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"group_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"partition", "offset"},
+			},
+			"instance_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"offset": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"partition": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"group_name", "instance_name", "commit_on_get"},
+			},
+			"time": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(oci_streaming.CreateCursorDetailsTypeTrimHorizon),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_streaming.CreateCursorDetailsTypeAfterOffset),
+					string(oci_streaming.CreateCursorDetailsTypeAtOffset),
+					string(oci_streaming.CreateCursorDetailsTypeAtTime),
+					string(oci_streaming.CreateCursorDetailsTypeLatest),
+					string(oci_streaming.CreateCursorDetailsTypeTrimHorizon),
+				}, false),
+			},
+			"is_truncated": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"messages": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"offset": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"partition": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"stream": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"timestamp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func readStreamingMessagesDataSource(d *schema.ResourceData, m interface{}) error {
+	if _, ok := d.GetOkExists("group_name"); ok {
+		if err := validateStreamingGroupCursorType(d.Get("type").(string)); err != nil {
+			return err
+		}
+	}
+
+	sync := &StreamingMessagesDataSourceCrud{}
+	sync.D = d
+	client, err := m.(*OracleClients).StreamClient(d.Get("messages_endpoint").(string))
+	if err != nil {
+		return err
+	}
+	sync.Client = client
+
+	return ReadResource(sync)
+}
+
+type StreamingMessagesDataSourceCrud struct {
+	D         *schema.ResourceData
+	Client    *oci_streaming.StreamClient
+	Res       []oci_streaming.Message
+	Truncated bool
+}
+
+func (s *StreamingMessagesDataSourceCrud) VoidState() {
+	s.D.SetId("")
+}
+
+// Get reads the stream from the cursor until it has caught up, and keeps the last limit messages it read. The read is
+// capped by readStreamingMessagesFromCursor so that a plan does not hang on a large stream, is_truncated tells when it was.
+func (s *StreamingMessagesDataSourceCrud) Get() error {
+	cursor, err := s.createCursor()
+	if err != nil {
+		return err
+	}
+
+	request := oci_streaming.GetMessagesRequest{}
+
+	streamId := s.D.Get("stream_id").(string)
+	request.StreamId = &streamId
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "streaming")
+
+	messages, truncated, err := readStreamingMessagesFromCursor(cursor, s.D.Get("limit").(int), streamingMessagesMaxPages, time.Now().Add(streamingMessagesMaxReadTime), func(cursor *string) ([]oci_streaming.Message, *string, error) {
+		request.Cursor = cursor
+
+		response, err := s.Client.GetMessages(context.Background(), request)
+		if err != nil {
+			return nil, nil, err
+		}
+		return response.Items, response.OpcNextCursor, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	s.Res = messages
+	s.Truncated = truncated
+	return nil
+}
+
+func (s *StreamingMessagesDataSourceCrud) createCursor() (*string, error) {
+	streamId := s.D.Get("stream_id").(string)
+	cursorType := s.D.Get("type").(string)
+
+	var cursorTime *oci_common.SDKTime
+	if timeStr, ok := s.D.GetOkExists("time"); ok {
+		tmp, err := time.Parse(time.RFC3339, timeStr.(string))
+		if err != nil {
+			return nil, err
+		}
+		cursorTime = &oci_common.SDKTime{Time: tmp}
+	}
+
+	if groupName, ok := s.D.GetOkExists("group_name"); ok {
+		request := oci_streaming.CreateGroupCursorRequest{}
+		request.StreamId = &streamId
+
+		tmp := groupName.(string)
+		request.GroupName = &tmp
+		request.Type = oci_streaming.CreateGroupCursorDetailsTypeEnum(cursorType)
+		request.Time = cursorTime
+
+		// The service commits the messages read by default, a data source only commits them when asked to
+		commitOnGet := s.D.Get("commit_on_get").(bool)
+		request.CommitOnGet = &commitOnGet
+
+		if instanceName, ok := s.D.GetOkExists("instance_name"); ok {
+			tmp := instanceName.(string)
+			request.InstanceName = &tmp
+		}
+
+		request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "streaming")
+
+		response, err := s.Client.CreateGroupCursor(context.Background(), request)
+		if err != nil {
+			return nil, err
+		}
+
+		return response.Value, nil
+	}
+
+	partition, ok := s.D.GetOkExists("partition")
+	if !ok {
+		return nil, fmt.Errorf("either partition or group_name must be specified")
+	}
+
+	request := oci_streaming.CreateCursorRequest{}
+	request.StreamId = &streamId
+
+	tmp := partition.(string)
+	request.Partition = &tmp
+	request.Type = oci_streaming.CreateCursorDetailsTypeEnum(cursorType)
+	request.Time = cursorTime
+
+	if offset, ok := s.D.GetOkExists("offset"); ok {
+		tmp, err := strconv.ParseInt(offset.(string), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("unable to convert offset string: %s to an int64 and encountered error: %v", offset.(string), err)
+		}
+		request.Offset = &tmp
+	}
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "streaming")
+
+	response, err := s.Client.CreateCursor(context.Background(), request)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Value, nil
+}
+
+func (s *StreamingMessagesDataSourceCrud) SetData() error {
+	if s.Res == nil {
+		return nil
+	}
+
+	s.D.SetId(GenerateDataSourceID())
+
+	s.D.Set("is_truncated", s.Truncated)
+
+	messages := []interface{}{}
+	for _, item := range s.Res {
+		messages = append(messages, StreamingMessageToMap(item))
+	}
+
+	if err := s.D.Set("messages", messages); err != nil {
+		return err
+	}
+
+	return nil
+}
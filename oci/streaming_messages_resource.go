@@ -0,0 +1,181 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	oci_streaming "github.com/oracle/oci-go-sdk/streaming"
+)
+
+func StreamingMessagesResource() *schema.Resource {
+	return &schema.Resource{
+		Timeouts: DefaultTimeout,
+		Create:   createStreamingMessages,
+		Read:     readStreamingMessages,
+		Delete:   deleteStreamingMessages,
+		Schema: map[string]*schema.Schema{
+			// Required
+			"messages": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// Required
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+
+						// Optional
+						"key": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+
+						// Computed
+						"offset": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"partition": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"timestamp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"messages_endpoint": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"stream_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func createStreamingMessages(d *schema.ResourceData, m interface{}) error {
+	sync := &StreamingMessagesResourceCrud{}
+	sync.D = d
+	client, err := m.(*OracleClients).StreamClient(d.Get("messages_endpoint").(string))
+	if err != nil {
+		return err
+	}
+	sync.Client = client
+
+	return CreateResource(d, sync)
+}
+
+func readStreamingMessages(d *schema.ResourceData, m interface{}) error {
+	sync := &StreamingMessagesResourceCrud{}
+	sync.D = d
+	client, err := m.(*OracleClients).StreamClient(d.Get("messages_endpoint").(string))
+	if err != nil {
+		return err
+	}
+	sync.Client = client
+
+	return ReadResource(sync)
+}
+
+// Published messages cannot be deleted, they are removed by the service once the stream's retention period is over
+func deleteStreamingMessages(d *schema.ResourceData, m interface{}) error {
+	return nil
+}
+
+type StreamingMessagesResourceCrud struct {
+	BaseCrud
+	Client *oci_streaming.StreamClient
+	Res    *oci_streaming.PutMessagesResult
+}
+
+func (s *StreamingMessagesResourceCrud) ID() string {
+	entry := s.Res.Entries[0]
+	return getStreamingMessagesCompositeId(s.D.Get("stream_id").(string), *entry.Partition, *entry.Offset)
+}
+
+func (s *StreamingMessagesResourceCrud) Create() error {
+	request := oci_streaming.PutMessagesRequest{}
+
+	streamId := s.D.Get("stream_id").(string)
+	request.StreamId = &streamId
+
+	for _, item := range s.D.Get("messages").([]interface{}) {
+		message := item.(map[string]interface{})
+		entry := oci_streaming.PutMessagesDetailsEntry{
+			Value: []byte(message["value"].(string)),
+		}
+		if key, ok := message["key"]; ok && key.(string) != "" {
+			entry.Key = []byte(key.(string))
+		}
+		request.Messages = append(request.Messages, entry)
+	}
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "streaming")
+
+	response, err := s.Client.PutMessages(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	if err := getStreamingPutMessagesError(response.PutMessagesResult); err != nil {
+		return err
+	}
+	if len(response.Entries) != len(request.Messages) {
+		return fmt.Errorf("expected %d published messages, got %d", len(request.Messages), len(response.Entries))
+	}
+
+	s.Res = &response.PutMessagesResult
+	return nil
+}
+
+// Messages are immutable, there is nothing to refresh once they have been published
+func (s *StreamingMessagesResourceCrud) Get() error {
+	return nil
+}
+
+func (s *StreamingMessagesResourceCrud) SetData() error {
+	if s.Res == nil {
+		return nil
+	}
+
+	messages := s.D.Get("messages").([]interface{})
+	for i, entry := range s.Res.Entries {
+		message := messages[i].(map[string]interface{})
+
+		if entry.Offset != nil {
+			message["offset"] = strconv.FormatInt(*entry.Offset, 10)
+		}
+
+		if entry.Partition != nil {
+			message["partition"] = *entry.Partition
+		}
+
+		if entry.Timestamp != nil {
+			message["timestamp"] = entry.Timestamp.String()
+		}
+	}
+
+	if err := s.D.Set("messages", messages); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/terraform-providers/terraform-provider-oci/httpreplay"
+)
+
+const (
+	StreamingMessagesResourceConfig = `
+resource "oci_streaming_messages" "test_messages" {
+	stream_id = "${oci_streaming_stream.test_stream.id}"
+	messages_endpoint = "${oci_streaming_stream.test_stream.messages_endpoint}"
+
+	messages {
+		key = "config"
+		value = "value1"
+	}
+	messages {
+		value = "value2"
+	}
+}
+`
+
+	StreamingMessagesDataSourceConfig = `
+data "oci_streaming_messages" "test_messages" {
+	stream_id = "${oci_streaming_stream.test_stream.id}"
+	messages_endpoint = "${oci_streaming_stream.test_stream.messages_endpoint}"
+	partition = "${oci_streaming_messages.test_messages.messages.0.partition}"
+	limit = 1
+}
+`
+)
+
+func TestStreamingMessagesResource_basic(t *testing.T) {
+	httpreplay.SetScenario("TestStreamingMessagesResource_basic")
+	defer httpreplay.SaveScenario()
+
+	provider := testAccProvider
+	config := testProviderConfig()
+
+	compartmentId := getEnvSettingWithBlankDefault("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	resourceName := "oci_streaming_messages.test_messages"
+	datasourceName := "data.oci_streaming_messages.test_messages"
+
+	streamConfig := generateResourceFromRepresentationMap("oci_streaming_stream", "test_stream", Required, Create, streamRepresentation)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Providers: map[string]terraform.ResourceProvider{
+			"oci": provider,
+		},
+		Steps: []resource.TestStep{
+			// verify publishing the messages
+			{
+				Config: config + compartmentIdVariableStr + StreamResourceDependencies + streamConfig + StreamingMessagesResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "messages.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "messages.0.key", "config"),
+					resource.TestCheckResourceAttr(resourceName, "messages.0.value", "value1"),
+					resource.TestCheckResourceAttrSet(resourceName, "messages.0.offset"),
+					resource.TestCheckResourceAttrSet(resourceName, "messages.0.partition"),
+					resource.TestCheckResourceAttrSet(resourceName, "messages.0.timestamp"),
+					resource.TestCheckResourceAttr(resourceName, "messages.1.value", "value2"),
+				),
+			},
+			// verify reading the last message back from the partition
+			{
+				Config: config + compartmentIdVariableStr + StreamResourceDependencies + streamConfig + StreamingMessagesResourceConfig + StreamingMessagesDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "messages.#", "1"),
+					resource.TestCheckResourceAttr(datasourceName, "messages.0.value", "value2"),
+					TestCheckResourceAttributesEqual(datasourceName, "messages.0.offset", resourceName, "messages.1.offset"),
+				),
+			},
+		},
+	})
+}
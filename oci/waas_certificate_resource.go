@@ -12,6 +12,9 @@ import (
 
 func WaasCertificateResource() *schema.Resource {
 	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Timeouts: DefaultTimeout,
 		Create:   createWaasCertificate,
 		Read:     readWaasCertificate,
@@ -0,0 +1,373 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	oci_waas "github.com/oracle/oci-go-sdk/waas"
+)
+
+func WaasProtectionRuleResource() *schema.Resource {
+	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: importWaasProtectionRule,
+		},
+		Timeouts:      DefaultTimeout,
+		Create:        createWaasProtectionRule,
+		Read:          readWaasProtectionRule,
+		Update:        updateWaasProtectionRule,
+		Delete:        deleteWaasProtectionRule,
+		CustomizeDiff: validateWaasProtectionRuleAction,
+		Schema: map[string]*schema.Schema{
+			// Required
+			"key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"waas_policy_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// Optional
+			"accept_recommendation": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"action"},
+			},
+			"action": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_waas.ProtectionRuleActionActionOff),
+					string(oci_waas.ProtectionRuleActionActionDetect),
+					string(oci_waas.ProtectionRuleActionActionBlock),
+				}, false),
+			},
+			"exclusions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// Required
+						"exclusions": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"target": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(oci_waas.ProtectionRuleExclusionTargetRequestCookies),
+								string(oci_waas.ProtectionRuleExclusionTargetRequestCookieNames),
+								string(oci_waas.ProtectionRuleExclusionTargetArgs),
+								string(oci_waas.ProtectionRuleExclusionTargetArgsNames),
+							}, false),
+						},
+
+						// Optional
+
+						// Computed
+					},
+				},
+			},
+
+			// Computed
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"labels": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"mod_security_rule_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func createWaasProtectionRule(d *schema.ResourceData, m interface{}) error {
+	sync := &WaasProtectionRuleResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).waasClient
+
+	return CreateResource(d, sync)
+}
+
+func readWaasProtectionRule(d *schema.ResourceData, m interface{}) error {
+	sync := &WaasProtectionRuleResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).waasClient
+
+	return ReadResource(sync)
+}
+
+func updateWaasProtectionRule(d *schema.ResourceData, m interface{}) error {
+	sync := &WaasProtectionRuleResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).waasClient
+
+	return UpdateResource(d, sync)
+}
+
+func deleteWaasProtectionRule(d *schema.ResourceData, m interface{}) error {
+	sync := &WaasProtectionRuleResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).waasClient
+	sync.DisableNotFoundRetries = true
+
+	return DeleteResource(d, sync)
+}
+
+type WaasProtectionRuleResourceCrud struct {
+	BaseCrud
+	Client                 *oci_waas.WaasClient
+	Res                    *oci_waas.ProtectionRule
+	DisableNotFoundRetries bool
+}
+
+func (s *WaasProtectionRuleResourceCrud) ID() string {
+	return getWaasPolicyChildCompositeId(s.D.Get("waas_policy_id").(string), "protectionRules", s.D.Get("key").(string))
+}
+
+func (s *WaasProtectionRuleResourceCrud) GetMutex() *sync.Mutex {
+	return waasPolicyMutexes.GetOrCreateMutex(s.D.Get("waas_policy_id").(string))
+}
+
+func (s *WaasProtectionRuleResourceCrud) Create() error {
+	if err := s.applyProtectionRule(s.D.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	return s.Get()
+}
+
+func (s *WaasProtectionRuleResourceCrud) Get() error {
+	request := oci_waas.GetProtectionRuleRequest{}
+
+	waasPolicyId := s.D.Get("waas_policy_id").(string)
+	request.WaasPolicyId = &waasPolicyId
+
+	key := s.D.Get("key").(string)
+	request.ProtectionRuleKey = &key
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "waas")
+
+	response, err := s.Client.GetProtectionRule(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.ProtectionRule
+	return nil
+}
+
+func (s *WaasProtectionRuleResourceCrud) Update() error {
+	if err := s.applyProtectionRule(s.D.Timeout(schema.TimeoutUpdate)); err != nil {
+		return err
+	}
+
+	return s.Get()
+}
+
+// Protection rules cannot be deleted, turn the rule off and remove its exclusions instead
+func (s *WaasProtectionRuleResourceCrud) Delete() error {
+	return s.updateProtectionRule(string(oci_waas.ProtectionRuleActionActionOff), false, s.D.Timeout(schema.TimeoutDelete))
+}
+
+// applyProtectionRule sets the configured action of the rule, or accepts its recommendation when accept_recommendation
+// is turned on, in which case the rule keeps the recommended action
+func (s *WaasProtectionRuleResourceCrud) applyProtectionRule(timeout time.Duration) error {
+	accept, _ := s.D.Get("accept_recommendation").(bool)
+	if !accept || !s.D.HasChange("accept_recommendation") {
+		return s.updateProtectionRule(s.D.Get("action").(string), true, timeout)
+	}
+
+	if err := s.acceptRecommendation(timeout); err != nil {
+		return err
+	}
+
+	if !s.D.HasChange("exclusions") {
+		return nil
+	}
+
+	if err := s.Get(); err != nil {
+		return err
+	}
+	return s.updateProtectionRule(string(s.Res.Action), true, timeout)
+}
+
+func (s *WaasProtectionRuleResourceCrud) acceptRecommendation(timeout time.Duration) error {
+	request := oci_waas.AcceptRecommendationsRequest{}
+
+	waasPolicyId := s.D.Get("waas_policy_id").(string)
+	request.WaasPolicyId = &waasPolicyId
+
+	request.ProtectionRuleKeys = &[]string{s.D.Get("key").(string)}
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "waas")
+
+	response, err := s.Client.AcceptRecommendations(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	_, err = waasPolicyWaitForWorkRequest(response.OpcWorkRequestId, "waas",
+		oci_waas.WorkRequestResourceActionTypeUpdated, timeout, s.DisableNotFoundRetries, s.Client)
+	return err
+}
+
+func (s *WaasProtectionRuleResourceCrud) updateProtectionRule(action string, withExclusions bool, timeout time.Duration) error {
+	request := oci_waas.UpdateProtectionRulesRequest{}
+
+	waasPolicyId := s.D.Get("waas_policy_id").(string)
+	request.WaasPolicyId = &waasPolicyId
+
+	key := s.D.Get("key").(string)
+	protectionRule := oci_waas.ProtectionRuleAction{
+		Key:        &key,
+		Action:     oci_waas.ProtectionRuleActionActionEnum(action),
+		Exclusions: []oci_waas.ProtectionRuleExclusion{},
+	}
+
+	if withExclusions {
+		if exclusions, ok := s.D.GetOkExists("exclusions"); ok {
+			interfaces := exclusions.([]interface{})
+			for i := range interfaces {
+				fieldKeyFormat := fmt.Sprintf("%s.%d.%%s", "exclusions", i)
+				converted, err := s.mapToProtectionRuleExclusion(fieldKeyFormat)
+				if err != nil {
+					return err
+				}
+				protectionRule.Exclusions = append(protectionRule.Exclusions, converted)
+			}
+		}
+	}
+	request.ProtectionRules = []oci_waas.ProtectionRuleAction{protectionRule}
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "waas")
+
+	response, err := s.Client.UpdateProtectionRules(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	_, err = waasPolicyWaitForWorkRequest(response.OpcWorkRequestId, "waas",
+		oci_waas.WorkRequestResourceActionTypeUpdated, timeout, s.DisableNotFoundRetries, s.Client)
+	return err
+}
+
+func (s *WaasProtectionRuleResourceCrud) SetData() error {
+	if s.Res == nil {
+		return nil
+	}
+
+	s.D.Set("action", string(s.Res.Action))
+
+	if s.Res.Description != nil {
+		s.D.Set("description", *s.Res.Description)
+	}
+
+	exclusions := []interface{}{}
+	for _, item := range s.Res.Exclusions {
+		exclusions = append(exclusions, ProtectionRuleExclusionToMap(item))
+	}
+	s.D.Set("exclusions", exclusions)
+
+	if s.Res.Key != nil {
+		s.D.Set("key", *s.Res.Key)
+	}
+
+	s.D.Set("labels", s.Res.Labels)
+
+	s.D.Set("mod_security_rule_ids", s.Res.ModSecurityRuleIds)
+
+	if s.Res.Name != nil {
+		s.D.Set("name", *s.Res.Name)
+	}
+
+	return nil
+}
+
+func (s *WaasProtectionRuleResourceCrud) mapToProtectionRuleExclusion(fieldKeyFormat string) (oci_waas.ProtectionRuleExclusion, error) {
+	result := oci_waas.ProtectionRuleExclusion{}
+
+	result.Exclusions = []string{}
+	if exclusions, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "exclusions")); ok {
+		interfaces := exclusions.([]interface{})
+		tmp := make([]string, len(interfaces))
+		for i := range interfaces {
+			if interfaces[i] != nil {
+				tmp[i] = interfaces[i].(string)
+			}
+		}
+		result.Exclusions = tmp
+	}
+
+	if target, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "target")); ok {
+		result.Target = oci_waas.ProtectionRuleExclusionTargetEnum(target.(string))
+	}
+
+	return result, nil
+}
+
+func ProtectionRuleExclusionToMap(obj oci_waas.ProtectionRuleExclusion) map[string]interface{} {
+	result := map[string]interface{}{}
+
+	result["exclusions"] = obj.Exclusions
+
+	result["target"] = string(obj.Target)
+
+	return result
+}
+
+func importWaasProtectionRule(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	waasPolicyId, key, err := parseWaasPolicyChildCompositeId(d.Id(), "protectionRules")
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("waas_policy_id", waasPolicyId)
+	d.Set("key", key)
+	return []*schema.ResourceData{d}, nil
+}
+
+// The action of a new rule is either set explicitly or taken from its recommendation
+func validateWaasProtectionRuleAction(d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() != "" {
+		return nil
+	}
+	if _, ok := d.GetOk("action"); ok {
+		return nil
+	}
+	if accept, ok := d.GetOk("accept_recommendation"); ok && accept.(bool) {
+		return nil
+	}
+	return fmt.Errorf("one of action or accept_recommendation must be set")
+}
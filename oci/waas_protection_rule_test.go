@@ -0,0 +1,147 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/terraform-providers/terraform-provider-oci/httpreplay"
+)
+
+var (
+	waasProtectionRuleRepresentation = map[string]interface{}{
+		"action":         Representation{repType: Required, create: `DETECT`, update: `BLOCK`},
+		"key":            Representation{repType: Required, create: `950351`},
+		"waas_policy_id": Representation{repType: Required, create: `${oci_waas_waas_policy.test_waas_policy.id}`},
+		"exclusions":     RepresentationGroup{Optional, waasProtectionRuleExclusionsRepresentation},
+	}
+	waasProtectionRuleExclusionsRepresentation = map[string]interface{}{
+		"exclusions": Representation{repType: Required, create: []string{`example.com`}, update: []string{`example.com`, `example.org`}},
+		"target":     Representation{repType: Required, create: `REQUEST_COOKIES`},
+	}
+
+	waasProtectionRuleAcceptRecommendationRepresentation = map[string]interface{}{
+		"accept_recommendation": Representation{repType: Required, create: `true`},
+		"key":                   Representation{repType: Required, create: `${lookup(data.oci_waas_recommendations.test_recommendations.recommendations[0], "key")}`},
+		"waas_policy_id":        Representation{repType: Required, create: `${oci_waas_waas_policy.test_waas_policy.id}`},
+	}
+
+	WaasProtectionRuleResourceDependencies = WaasPolicyRequiredOnlyResource
+)
+
+func TestWaasProtectionRuleResource_basic(t *testing.T) {
+	httpreplay.SetScenario("TestWaasProtectionRuleResource_basic")
+	defer httpreplay.SaveScenario()
+
+	provider := testAccProvider
+	config := testProviderConfig()
+
+	compartmentId := getEnvSettingWithBlankDefault("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	resourceName := "oci_waas_protection_rule.test_protection_rule"
+	datasourceName := "data.oci_waas_recommendations.test_recommendations"
+
+	var resId, resId2 string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Providers: map[string]terraform.ResourceProvider{
+			"oci": provider,
+		},
+		Steps: []resource.TestStep{
+			// verify create with optionals
+			{
+				Config: config + compartmentIdVariableStr + WaasProtectionRuleResourceDependencies +
+					generateResourceFromRepresentationMap("oci_waas_protection_rule", "test_protection_rule", Optional, Create, waasProtectionRuleRepresentation),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "action", "DETECT"),
+					resource.TestCheckResourceAttr(resourceName, "exclusions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "exclusions.0.exclusions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "exclusions.0.target", "REQUEST_COOKIES"),
+					resource.TestCheckResourceAttr(resourceName, "key", "950351"),
+					resource.TestCheckResourceAttrSet(resourceName, "name"),
+					resource.TestCheckResourceAttrSet(resourceName, "waas_policy_id"),
+
+					func(s *terraform.State) (err error) {
+						resId, err = fromInstanceState(s, resourceName, "id")
+						return err
+					},
+				),
+			},
+
+			// verify updates to updatable parameters
+			{
+				Config: config + compartmentIdVariableStr + WaasProtectionRuleResourceDependencies +
+					generateResourceFromRepresentationMap("oci_waas_protection_rule", "test_protection_rule", Optional, Update, waasProtectionRuleRepresentation),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "action", "BLOCK"),
+					resource.TestCheckResourceAttr(resourceName, "exclusions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "exclusions.0.exclusions.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "exclusions.0.target", "REQUEST_COOKIES"),
+					resource.TestCheckResourceAttr(resourceName, "key", "950351"),
+
+					func(s *terraform.State) (err error) {
+						resId2, err = fromInstanceState(s, resourceName, "id")
+						if resId != resId2 {
+							return fmt.Errorf("Resource recreated when it was supposed to be updated.")
+						}
+						return err
+					},
+				),
+			},
+			// verify accepting a recommendation sets the recommended action
+			{
+				Config: config + compartmentIdVariableStr + WaasProtectionRuleResourceDependencies +
+					generateDataSourceFromRepresentationMap("oci_waas_recommendations", "test_recommendations", Optional, Create, waasRecommendationsDataSourceRepresentation) +
+					generateResourceFromRepresentationMap("oci_waas_protection_rule", "test_accepted_protection_rule", Required, Create, waasProtectionRuleAcceptRecommendationRepresentation),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("oci_waas_protection_rule.test_accepted_protection_rule", "accept_recommendation", "true"),
+					resource.TestCheckResourceAttr("oci_waas_protection_rule.test_accepted_protection_rule", "action", "DETECT"),
+					resource.TestCheckResourceAttrPair("oci_waas_protection_rule.test_accepted_protection_rule", "key", datasourceName, "recommendations.0.key"),
+				),
+			},
+			// verify resource import
+			{
+				Config: config + compartmentIdVariableStr + WaasProtectionRuleResourceDependencies +
+					generateResourceFromRepresentationMap("oci_waas_protection_rule", "test_protection_rule", Optional, Update, waasProtectionRuleRepresentation),
+				ImportState:       true,
+				ImportStateVerify: true,
+				ResourceName:      resourceName,
+			},
+		},
+	})
+}
+
+func TestUnitWaasProtectionRuleResource_actionOrAcceptRecommendation(t *testing.T) {
+	ruleConfig := func(c map[string]interface{}) *terraform.ResourceConfig {
+		raw, err := config.NewRawConfig(c)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return terraform.NewResourceConfig(raw)
+	}
+	waasProtectionRule := WaasProtectionRuleResource()
+
+	if _, err := waasProtectionRule.Diff(nil, ruleConfig(map[string]interface{}{"key": "950351", "waas_policy_id": "ocid1.waaspolicy.oc1..aaaa"}), nil); err == nil {
+		t.Errorf("Expected an error when neither action nor accept_recommendation is set")
+	}
+
+	for _, c := range []map[string]interface{}{
+		{"key": "950351", "waas_policy_id": "ocid1.waaspolicy.oc1..aaaa", "action": "BLOCK"},
+		{"key": "950351", "waas_policy_id": "ocid1.waaspolicy.oc1..aaaa", "accept_recommendation": true},
+	} {
+		if _, err := waasProtectionRule.Diff(nil, ruleConfig(c), nil); err != nil {
+			t.Errorf("Unexpected error for %v: %v", c, err)
+		}
+	}
+
+	if _, errs := waasProtectionRule.Validate(ruleConfig(map[string]interface{}{"key": "950351", "waas_policy_id": "ocid1.waaspolicy.oc1..aaaa", "action": "BLOCK", "accept_recommendation": true})); len(errs) == 0 {
+		t.Errorf("Expected action and accept_recommendation to conflict")
+	}
+}
@@ -0,0 +1,171 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	oci_waas "github.com/oracle/oci-go-sdk/waas"
+)
+
+func WaasRecommendationsDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: readWaasRecommendations,
+		Schema: map[string]*schema.Schema{
+			"filter": dataSourceFiltersSchema(),
+			"waas_policy_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"recommended_action": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_waas.ListRecommendationsRecommendedActionDetect),
+					string(oci_waas.ListRecommendationsRecommendedActionBlock),
+				}, false),
+			},
+			"recommendations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"labels": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"mod_security_rule_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"recommended_action": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func readWaasRecommendations(d *schema.ResourceData, m interface{}) error {
+	sync := &WaasRecommendationsDataSourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).waasClient
+
+	return ReadResource(sync)
+}
+
+type WaasRecommendationsDataSourceCrud struct {
+	D      *schema.ResourceData
+	Client *oci_waas.WaasClient
+	Res    *oci_waas.ListRecommendationsResponse
+}
+
+func (s *WaasRecommendationsDataSourceCrud) VoidState() {
+	s.D.SetId("")
+}
+
+func (s *WaasRecommendationsDataSourceCrud) Get() error {
+	request := oci_waas.ListRecommendationsRequest{}
+
+	waasPolicyId := s.D.Get("waas_policy_id").(string)
+	request.WaasPolicyId = &waasPolicyId
+
+	if recommendedAction, ok := s.D.GetOkExists("recommended_action"); ok {
+		request.RecommendedAction = oci_waas.ListRecommendationsRecommendedActionEnum(recommendedAction.(string))
+	}
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "waas")
+
+	response, err := s.Client.ListRecommendations(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response
+	request.Page = s.Res.OpcNextPage
+
+	for request.Page != nil {
+		listResponse, err := s.Client.ListRecommendations(context.Background(), request)
+		if err != nil {
+			return err
+		}
+
+		s.Res.Items = append(s.Res.Items, listResponse.Items...)
+		request.Page = listResponse.OpcNextPage
+	}
+
+	return nil
+}
+
+func (s *WaasRecommendationsDataSourceCrud) recommendations() []map[string]interface{} {
+	resources := []map[string]interface{}{}
+
+	for _, r := range s.Res.Items {
+		recommendation := map[string]interface{}{}
+
+		if r.Description != nil {
+			recommendation["description"] = *r.Description
+		}
+
+		if r.Key != nil {
+			recommendation["key"] = *r.Key
+		}
+
+		recommendation["labels"] = r.Labels
+
+		recommendation["mod_security_rule_ids"] = r.ModSecurityRuleIds
+
+		if r.Name != nil {
+			recommendation["name"] = *r.Name
+		}
+
+		if r.RecommendedAction != nil {
+			recommendation["recommended_action"] = *r.RecommendedAction
+		}
+
+		resources = append(resources, recommendation)
+	}
+
+	if f, fOk := s.D.GetOkExists("filter"); fOk {
+		resources = ApplyFilters(f.(*schema.Set), resources, WaasRecommendationsDataSource().Schema["recommendations"].Elem.(*schema.Resource).Schema)
+	}
+
+	return resources
+}
+
+func (s *WaasRecommendationsDataSourceCrud) SetData() error {
+	if s.Res == nil {
+		return nil
+	}
+
+	s.D.SetId(GenerateDataSourceID())
+
+	if err := s.D.Set("recommendations", s.recommendations()); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/terraform-providers/terraform-provider-oci/httpreplay"
+)
+
+var (
+	waasRecommendationsDataSourceRepresentation = map[string]interface{}{
+		"waas_policy_id":     Representation{repType: Required, create: `${oci_waas_waas_policy.test_waas_policy.id}`},
+		"recommended_action": Representation{repType: Optional, create: `DETECT`},
+	}
+
+	WaasRecommendationsResourceConfig = WaasPolicyRequiredOnlyResource
+)
+
+func TestWaasRecommendationsDataSource_basic(t *testing.T) {
+	httpreplay.SetScenario("TestWaasRecommendationsDataSource_basic")
+	defer httpreplay.SaveScenario()
+
+	provider := testAccProvider
+	config := testProviderConfig()
+
+	compartmentId := getEnvSettingWithBlankDefault("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	datasourceName := "data.oci_waas_recommendations.test_recommendations"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Providers: map[string]terraform.ResourceProvider{
+			"oci": provider,
+		},
+		Steps: []resource.TestStep{
+			// verify datasource
+			{
+				Config: config + compartmentIdVariableStr + WaasRecommendationsResourceConfig +
+					generateDataSourceFromRepresentationMap("oci_waas_recommendations", "test_recommendations", Optional, Create, waasRecommendationsDataSourceRepresentation),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(datasourceName, "waas_policy_id"),
+					resource.TestCheckResourceAttr(datasourceName, "recommended_action", "DETECT"),
+					resource.TestCheckResourceAttrSet(datasourceName, "recommendations.#"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,224 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	oci_waas "github.com/oracle/oci-go-sdk/waas"
+)
+
+func WaasThreatFeedResource() *schema.Resource {
+	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: importWaasThreatFeed,
+		},
+		Timeouts: DefaultTimeout,
+		Create:   createWaasThreatFeed,
+		Read:     readWaasThreatFeed,
+		Update:   updateWaasThreatFeed,
+		Delete:   deleteWaasThreatFeed,
+		Schema: map[string]*schema.Schema{
+			// Required
+			"action": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_waas.ThreatFeedActionActionOff),
+					string(oci_waas.ThreatFeedActionActionDetect),
+					string(oci_waas.ThreatFeedActionActionBlock),
+				}, false),
+			},
+			"key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"waas_policy_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// Optional
+
+			// Computed
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func createWaasThreatFeed(d *schema.ResourceData, m interface{}) error {
+	sync := &WaasThreatFeedResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).waasClient
+
+	return CreateResource(d, sync)
+}
+
+func readWaasThreatFeed(d *schema.ResourceData, m interface{}) error {
+	sync := &WaasThreatFeedResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).waasClient
+
+	return ReadResource(sync)
+}
+
+func updateWaasThreatFeed(d *schema.ResourceData, m interface{}) error {
+	sync := &WaasThreatFeedResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).waasClient
+
+	return UpdateResource(d, sync)
+}
+
+func deleteWaasThreatFeed(d *schema.ResourceData, m interface{}) error {
+	sync := &WaasThreatFeedResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).waasClient
+	sync.DisableNotFoundRetries = true
+
+	return DeleteResource(d, sync)
+}
+
+type WaasThreatFeedResourceCrud struct {
+	BaseCrud
+	Client                 *oci_waas.WaasClient
+	Res                    *oci_waas.ThreatFeed
+	DisableNotFoundRetries bool
+}
+
+func (s *WaasThreatFeedResourceCrud) ID() string {
+	return getWaasPolicyChildCompositeId(s.D.Get("waas_policy_id").(string), "threatFeeds", s.D.Get("key").(string))
+}
+
+func (s *WaasThreatFeedResourceCrud) GetMutex() *sync.Mutex {
+	return waasPolicyMutexes.GetOrCreateMutex(s.D.Get("waas_policy_id").(string))
+}
+
+func (s *WaasThreatFeedResourceCrud) Create() error {
+	if err := s.updateThreatFeed(s.D.Get("action").(string), s.D.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	return s.Get()
+}
+
+// There is no API to get a single threat feed, find it in the threat feeds of the policy
+func (s *WaasThreatFeedResourceCrud) Get() error {
+	request := oci_waas.ListThreatFeedsRequest{}
+
+	waasPolicyId := s.D.Get("waas_policy_id").(string)
+	request.WaasPolicyId = &waasPolicyId
+
+	key := s.D.Get("key").(string)
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "waas")
+
+	for {
+		response, err := s.Client.ListThreatFeeds(context.Background(), request)
+		if err != nil {
+			return err
+		}
+
+		for _, item := range response.Items {
+			if item.Key != nil && *item.Key == key {
+				threatFeed := item
+				s.Res = &threatFeed
+				return nil
+			}
+		}
+
+		if response.OpcNextPage == nil {
+			break
+		}
+		request.Page = response.OpcNextPage
+	}
+
+	return fmt.Errorf("threat feed %s not found in waas policy %s", key, waasPolicyId)
+}
+
+func (s *WaasThreatFeedResourceCrud) Update() error {
+	if err := s.updateThreatFeed(s.D.Get("action").(string), s.D.Timeout(schema.TimeoutUpdate)); err != nil {
+		return err
+	}
+
+	return s.Get()
+}
+
+// Threat feeds cannot be deleted, turn the threat feed off instead
+func (s *WaasThreatFeedResourceCrud) Delete() error {
+	return s.updateThreatFeed(string(oci_waas.ThreatFeedActionActionOff), s.D.Timeout(schema.TimeoutDelete))
+}
+
+func (s *WaasThreatFeedResourceCrud) updateThreatFeed(action string, timeout time.Duration) error {
+	request := oci_waas.UpdateThreatFeedsRequest{}
+
+	waasPolicyId := s.D.Get("waas_policy_id").(string)
+	request.WaasPolicyId = &waasPolicyId
+
+	key := s.D.Get("key").(string)
+	request.ThreatFeeds = []oci_waas.ThreatFeedAction{
+		{
+			Key:    &key,
+			Action: oci_waas.ThreatFeedActionActionEnum(action),
+		},
+	}
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "waas")
+
+	response, err := s.Client.UpdateThreatFeeds(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	_, err = waasPolicyWaitForWorkRequest(response.OpcWorkRequestId, "waas",
+		oci_waas.WorkRequestResourceActionTypeUpdated, timeout, s.DisableNotFoundRetries, s.Client)
+	return err
+}
+
+func (s *WaasThreatFeedResourceCrud) SetData() error {
+	if s.Res == nil {
+		return nil
+	}
+
+	s.D.Set("action", string(s.Res.Action))
+
+	if s.Res.Description != nil {
+		s.D.Set("description", *s.Res.Description)
+	}
+
+	if s.Res.Key != nil {
+		s.D.Set("key", *s.Res.Key)
+	}
+
+	if s.Res.Name != nil {
+		s.D.Set("name", *s.Res.Name)
+	}
+
+	return nil
+}
+
+func importWaasThreatFeed(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	waasPolicyId, key, err := parseWaasPolicyChildCompositeId(d.Id(), "threatFeeds")
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("waas_policy_id", waasPolicyId)
+	d.Set("key", key)
+	return []*schema.ResourceData{d}, nil
+}
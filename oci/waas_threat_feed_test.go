@@ -0,0 +1,112 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/terraform-providers/terraform-provider-oci/httpreplay"
+)
+
+var (
+	waasThreatFeedRepresentation = map[string]interface{}{
+		"action":         Representation{repType: Required, create: `DETECT`, update: `BLOCK`},
+		"key":            Representation{repType: Required, create: `${var.threat_feed_key}`},
+		"waas_policy_id": Representation{repType: Required, create: `${oci_waas_waas_policy.test_waas_policy.id}`},
+	}
+
+	WaasThreatFeedResourceDependencies = WaasPolicyRequiredOnlyResource
+)
+
+func TestWaasThreatFeedResource_basic(t *testing.T) {
+	httpreplay.SetScenario("TestWaasThreatFeedResource_basic")
+	defer httpreplay.SaveScenario()
+
+	// The keys of the threat feeds are not listed by any data source
+	threatFeedKey := getEnvSettingWithBlankDefault("waas_threat_feed_key")
+	if threatFeedKey == "" {
+		t.Skip("TF_VAR_waas_threat_feed_key is not set")
+	}
+
+	provider := testAccProvider
+	config := testProviderConfig()
+
+	compartmentId := getEnvSettingWithBlankDefault("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+	threatFeedKeyVariableStr := fmt.Sprintf("variable \"threat_feed_key\" { default = \"%s\" }\n", threatFeedKey)
+
+	resourceName := "oci_waas_threat_feed.test_threat_feed"
+
+	var resId, resId2 string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Providers: map[string]terraform.ResourceProvider{
+			"oci": provider,
+		},
+		Steps: []resource.TestStep{
+			// verify create
+			{
+				Config: config + compartmentIdVariableStr + threatFeedKeyVariableStr + WaasThreatFeedResourceDependencies +
+					generateResourceFromRepresentationMap("oci_waas_threat_feed", "test_threat_feed", Required, Create, waasThreatFeedRepresentation),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "action", "DETECT"),
+					resource.TestCheckResourceAttr(resourceName, "key", threatFeedKey),
+					resource.TestCheckResourceAttrSet(resourceName, "name"),
+					resource.TestCheckResourceAttrSet(resourceName, "waas_policy_id"),
+
+					func(s *terraform.State) (err error) {
+						resId, err = fromInstanceState(s, resourceName, "id")
+						return err
+					},
+				),
+			},
+
+			// verify updates to updatable parameters
+			{
+				Config: config + compartmentIdVariableStr + threatFeedKeyVariableStr + WaasThreatFeedResourceDependencies +
+					generateResourceFromRepresentationMap("oci_waas_threat_feed", "test_threat_feed", Required, Update, waasThreatFeedRepresentation),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "action", "BLOCK"),
+					resource.TestCheckResourceAttr(resourceName, "key", threatFeedKey),
+
+					func(s *terraform.State) (err error) {
+						resId2, err = fromInstanceState(s, resourceName, "id")
+						if resId != resId2 {
+							return fmt.Errorf("Resource recreated when it was supposed to be updated.")
+						}
+						return err
+					},
+				),
+			},
+			// verify resource import
+			{
+				Config:            config + compartmentIdVariableStr + threatFeedKeyVariableStr + WaasThreatFeedResourceDependencies,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ResourceName:      resourceName,
+			},
+		},
+	})
+}
+
+func TestUnitWaasThreatFeedResource_import(t *testing.T) {
+	d := WaasThreatFeedResource().Data(nil)
+	d.SetId(getWaasPolicyChildCompositeId("ocid1.waaspolicy.oc1..aaaa", "threatFeeds", "threat-feed/key"))
+
+	if _, err := importWaasThreatFeed(d, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if d.Get("waas_policy_id").(string) != "ocid1.waaspolicy.oc1..aaaa" || d.Get("key").(string) != "threat-feed/key" {
+		t.Errorf("unexpected waas_policy_id %s and key %s", d.Get("waas_policy_id"), d.Get("key"))
+	}
+
+	d.SetId(getWaasPolicyChildCompositeId("ocid1.waaspolicy.oc1..aaaa", "protectionRules", "950351"))
+	if _, err := importWaasThreatFeed(d, nil); err == nil {
+		t.Errorf("expected an error for the ID of a protection rule")
+	}
+}
@@ -0,0 +1,191 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	oci_common "github.com/oracle/oci-go-sdk/common"
+	oci_waas "github.com/oracle/oci-go-sdk/waas"
+)
+
+func WaasWafBlockedRequestsDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: readWaasWafBlockedRequests,
+		Schema: map[string]*schema.Schema{
+			"filter": dataSourceFiltersSchema(),
+			"waas_policy_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"waf_features": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(oci_waas.ListWafBlockedRequestsWafFeatureProtectionRules),
+						string(oci_waas.ListWafBlockedRequestsWafFeatureJsChallenge),
+						string(oci_waas.ListWafBlockedRequestsWafFeatureAccessRules),
+						string(oci_waas.ListWafBlockedRequestsWafFeatureThreatFeeds),
+						string(oci_waas.ListWafBlockedRequestsWafFeatureHumanInteractionChallenge),
+						string(oci_waas.ListWafBlockedRequestsWafFeatureDeviceFingerprintChallenge),
+						string(oci_waas.ListWafBlockedRequestsWafFeatureCaptcha),
+						string(oci_waas.ListWafBlockedRequestsWafFeatureAddressRateLimiting),
+					}, false),
+				},
+			},
+			"time_observed_greater_than_or_equal_to": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRFC3339TimeString,
+			},
+			"time_observed_less_than": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRFC3339TimeString,
+			},
+			"waf_blocked_requests": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"time_observed": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"time_range_in_seconds": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"waf_feature": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func readWaasWafBlockedRequests(d *schema.ResourceData, m interface{}) error {
+	sync := &WaasWafBlockedRequestsDataSourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).waasClient
+
+	return ReadResource(sync)
+}
+
+type WaasWafBlockedRequestsDataSourceCrud struct {
+	D      *schema.ResourceData
+	Client *oci_waas.WaasClient
+	Res    *oci_waas.ListWafBlockedRequestsResponse
+}
+
+func (s *WaasWafBlockedRequestsDataSourceCrud) VoidState() {
+	s.D.SetId("")
+}
+
+func (s *WaasWafBlockedRequestsDataSourceCrud) Get() error {
+	request := oci_waas.ListWafBlockedRequestsRequest{}
+
+	waasPolicyId := s.D.Get("waas_policy_id").(string)
+	request.WaasPolicyId = &waasPolicyId
+
+	request.WafFeature = []oci_waas.ListWafBlockedRequestsWafFeatureEnum{}
+	if wafFeatures, ok := s.D.GetOkExists("waf_features"); ok {
+		interfaces := wafFeatures.([]interface{})
+		tmp := make([]oci_waas.ListWafBlockedRequestsWafFeatureEnum, len(interfaces))
+		for i := range interfaces {
+			if interfaces[i] != nil {
+				tmp[i] = oci_waas.ListWafBlockedRequestsWafFeatureEnum(interfaces[i].(string))
+			}
+		}
+		request.WafFeature = tmp
+	}
+
+	if timeObservedGreaterThanOrEqualTo, ok := s.D.GetOkExists("time_observed_greater_than_or_equal_to"); ok {
+		tmp, err := time.Parse(time.RFC3339, timeObservedGreaterThanOrEqualTo.(string))
+		if err != nil {
+			return err
+		}
+		request.TimeObservedGreaterThanOrEqualTo = &oci_common.SDKTime{Time: tmp}
+	}
+
+	if timeObservedLessThan, ok := s.D.GetOkExists("time_observed_less_than"); ok {
+		tmp, err := time.Parse(time.RFC3339, timeObservedLessThan.(string))
+		if err != nil {
+			return err
+		}
+		request.TimeObservedLessThan = &oci_common.SDKTime{Time: tmp}
+	}
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "waas")
+
+	response, err := s.Client.ListWafBlockedRequests(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response
+	request.Page = s.Res.OpcNextPage
+
+	for request.Page != nil {
+		listResponse, err := s.Client.ListWafBlockedRequests(context.Background(), request)
+		if err != nil {
+			return err
+		}
+
+		s.Res.Items = append(s.Res.Items, listResponse.Items...)
+		request.Page = listResponse.OpcNextPage
+	}
+
+	return nil
+}
+
+func (s *WaasWafBlockedRequestsDataSourceCrud) SetData() error {
+	if s.Res == nil {
+		return nil
+	}
+
+	s.D.SetId(GenerateDataSourceID())
+	resources := []map[string]interface{}{}
+
+	for _, r := range s.Res.Items {
+		wafBlockedRequest := map[string]interface{}{}
+
+		if r.Count != nil {
+			wafBlockedRequest["count"] = *r.Count
+		}
+
+		if r.TimeObserved != nil {
+			wafBlockedRequest["time_observed"] = r.TimeObserved.String()
+		}
+
+		if r.TimeRangeInSeconds != nil {
+			wafBlockedRequest["time_range_in_seconds"] = *r.TimeRangeInSeconds
+		}
+
+		wafBlockedRequest["waf_feature"] = r.WafFeature
+
+		resources = append(resources, wafBlockedRequest)
+	}
+
+	if f, fOk := s.D.GetOkExists("filter"); fOk {
+		resources = ApplyFilters(f.(*schema.Set), resources, WaasWafBlockedRequestsDataSource().Schema["waf_blocked_requests"].Elem.(*schema.Resource).Schema)
+	}
+
+	if err := s.D.Set("waf_blocked_requests", resources); err != nil {
+		return err
+	}
+
+	return nil
+}
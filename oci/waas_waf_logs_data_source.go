@@ -0,0 +1,694 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	oci_common "github.com/oracle/oci-go-sdk/common"
+	oci_waas "github.com/oracle/oci-go-sdk/waas"
+)
+
+func WaasWafLogsDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: readWaasWafLogs,
+		Schema: map[string]*schema.Schema{
+			"filter": dataSourceFiltersSchema(),
+			"waas_policy_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"access_rule_keys": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"actions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(oci_waas.ListWafLogsActionBlock),
+						string(oci_waas.ListWafLogsActionDetect),
+						string(oci_waas.ListWafLogsActionBypass),
+						string(oci_waas.ListWafLogsActionLog),
+						string(oci_waas.ListWafLogsActionRedirected),
+					}, false),
+				},
+			},
+			"client_addresses": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"country_codes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"country_names": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"fingerprints": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"http_methods": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(oci_waas.ListWafLogsHttpMethodOptions),
+						string(oci_waas.ListWafLogsHttpMethodGet),
+						string(oci_waas.ListWafLogsHttpMethodHead),
+						string(oci_waas.ListWafLogsHttpMethodPost),
+						string(oci_waas.ListWafLogsHttpMethodPut),
+						string(oci_waas.ListWafLogsHttpMethodDelete),
+						string(oci_waas.ListWafLogsHttpMethodTrace),
+						string(oci_waas.ListWafLogsHttpMethodConnect),
+					}, false),
+				},
+			},
+			"incident_keys": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"log_types": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(oci_waas.ListWafLogsLogTypeAccess),
+						string(oci_waas.ListWafLogsLogTypeProtectionRules),
+						string(oci_waas.ListWafLogsLogTypeJsChallenge),
+						string(oci_waas.ListWafLogsLogTypeCaptcha),
+						string(oci_waas.ListWafLogsLogTypeAccessRules),
+						string(oci_waas.ListWafLogsLogTypeThreatFeeds),
+						string(oci_waas.ListWafLogsLogTypeHumanInteractionChallenge),
+						string(oci_waas.ListWafLogsLogTypeDeviceFingerprintChallenge),
+						string(oci_waas.ListWafLogsLogTypeAddressRateLimiting),
+					}, false),
+				},
+			},
+			"origin_addresses": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"protection_rule_keys": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"referrers": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"request_urls": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"response_codes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"threat_feed_keys": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"user_agents": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"text_contains": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"time_observed_greater_than_or_equal_to": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRFC3339TimeString,
+			},
+			"time_observed_less_than": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRFC3339TimeString,
+			},
+			"waf_logs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"access_rule_key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"action": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"address_rate_limiting_key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"captcha_action": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"captcha_expected": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"captcha_fail_count": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"captcha_received": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"client_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"country_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"country_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"device": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"domain": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"fingerprint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"http_headers": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     schema.TypeString,
+						},
+						"http_method": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"incident_key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"log_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"origin_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"origin_response_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"protection_rule_detections": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     schema.TypeString,
+						},
+						"referrer": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"request_headers": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     schema.TypeString,
+						},
+						"request_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"response_code": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"response_size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"threat_feed_key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"timestamp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user_agent": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func readWaasWafLogs(d *schema.ResourceData, m interface{}) error {
+	sync := &WaasWafLogsDataSourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).waasClient
+
+	return ReadResource(sync)
+}
+
+type WaasWafLogsDataSourceCrud struct {
+	D      *schema.ResourceData
+	Client *oci_waas.WaasClient
+	Res    *oci_waas.ListWafLogsResponse
+}
+
+func (s *WaasWafLogsDataSourceCrud) VoidState() {
+	s.D.SetId("")
+}
+
+func (s *WaasWafLogsDataSourceCrud) Get() error {
+	request := oci_waas.ListWafLogsRequest{}
+
+	waasPolicyId := s.D.Get("waas_policy_id").(string)
+	request.WaasPolicyId = &waasPolicyId
+
+	request.AccessRuleKey = []string{}
+	if accessRuleKeys, ok := s.D.GetOkExists("access_rule_keys"); ok {
+		interfaces := accessRuleKeys.([]interface{})
+		tmp := make([]string, len(interfaces))
+		for i := range interfaces {
+			if interfaces[i] != nil {
+				tmp[i] = interfaces[i].(string)
+			}
+		}
+		request.AccessRuleKey = tmp
+	}
+
+	request.Action = []oci_waas.ListWafLogsActionEnum{}
+	if actions, ok := s.D.GetOkExists("actions"); ok {
+		interfaces := actions.([]interface{})
+		tmp := make([]oci_waas.ListWafLogsActionEnum, len(interfaces))
+		for i := range interfaces {
+			if interfaces[i] != nil {
+				tmp[i] = oci_waas.ListWafLogsActionEnum(interfaces[i].(string))
+			}
+		}
+		request.Action = tmp
+	}
+
+	request.ClientAddress = []string{}
+	if clientAddresses, ok := s.D.GetOkExists("client_addresses"); ok {
+		interfaces := clientAddresses.([]interface{})
+		tmp := make([]string, len(interfaces))
+		for i := range interfaces {
+			if interfaces[i] != nil {
+				tmp[i] = interfaces[i].(string)
+			}
+		}
+		request.ClientAddress = tmp
+	}
+
+	request.CountryCode = []string{}
+	if countryCodes, ok := s.D.GetOkExists("country_codes"); ok {
+		interfaces := countryCodes.([]interface{})
+		tmp := make([]string, len(interfaces))
+		for i := range interfaces {
+			if interfaces[i] != nil {
+				tmp[i] = interfaces[i].(string)
+			}
+		}
+		request.CountryCode = tmp
+	}
+
+	request.CountryName = []string{}
+	if countryNames, ok := s.D.GetOkExists("country_names"); ok {
+		interfaces := countryNames.([]interface{})
+		tmp := make([]string, len(interfaces))
+		for i := range interfaces {
+			if interfaces[i] != nil {
+				tmp[i] = interfaces[i].(string)
+			}
+		}
+		request.CountryName = tmp
+	}
+
+	request.Fingerprint = []string{}
+	if fingerprints, ok := s.D.GetOkExists("fingerprints"); ok {
+		interfaces := fingerprints.([]interface{})
+		tmp := make([]string, len(interfaces))
+		for i := range interfaces {
+			if interfaces[i] != nil {
+				tmp[i] = interfaces[i].(string)
+			}
+		}
+		request.Fingerprint = tmp
+	}
+
+	request.HttpMethod = []oci_waas.ListWafLogsHttpMethodEnum{}
+	if httpMethods, ok := s.D.GetOkExists("http_methods"); ok {
+		interfaces := httpMethods.([]interface{})
+		tmp := make([]oci_waas.ListWafLogsHttpMethodEnum, len(interfaces))
+		for i := range interfaces {
+			if interfaces[i] != nil {
+				tmp[i] = oci_waas.ListWafLogsHttpMethodEnum(interfaces[i].(string))
+			}
+		}
+		request.HttpMethod = tmp
+	}
+
+	request.IncidentKey = []string{}
+	if incidentKeys, ok := s.D.GetOkExists("incident_keys"); ok {
+		interfaces := incidentKeys.([]interface{})
+		tmp := make([]string, len(interfaces))
+		for i := range interfaces {
+			if interfaces[i] != nil {
+				tmp[i] = interfaces[i].(string)
+			}
+		}
+		request.IncidentKey = tmp
+	}
+
+	request.LogType = []oci_waas.ListWafLogsLogTypeEnum{}
+	if logTypes, ok := s.D.GetOkExists("log_types"); ok {
+		interfaces := logTypes.([]interface{})
+		tmp := make([]oci_waas.ListWafLogsLogTypeEnum, len(interfaces))
+		for i := range interfaces {
+			if interfaces[i] != nil {
+				tmp[i] = oci_waas.ListWafLogsLogTypeEnum(interfaces[i].(string))
+			}
+		}
+		request.LogType = tmp
+	}
+
+	request.OriginAddress = []string{}
+	if originAddresses, ok := s.D.GetOkExists("origin_addresses"); ok {
+		interfaces := originAddresses.([]interface{})
+		tmp := make([]string, len(interfaces))
+		for i := range interfaces {
+			if interfaces[i] != nil {
+				tmp[i] = interfaces[i].(string)
+			}
+		}
+		request.OriginAddress = tmp
+	}
+
+	request.ProtectionRuleKey = []string{}
+	if protectionRuleKeys, ok := s.D.GetOkExists("protection_rule_keys"); ok {
+		interfaces := protectionRuleKeys.([]interface{})
+		tmp := make([]string, len(interfaces))
+		for i := range interfaces {
+			if interfaces[i] != nil {
+				tmp[i] = interfaces[i].(string)
+			}
+		}
+		request.ProtectionRuleKey = tmp
+	}
+
+	request.Referrer = []string{}
+	if referrers, ok := s.D.GetOkExists("referrers"); ok {
+		interfaces := referrers.([]interface{})
+		tmp := make([]string, len(interfaces))
+		for i := range interfaces {
+			if interfaces[i] != nil {
+				tmp[i] = interfaces[i].(string)
+			}
+		}
+		request.Referrer = tmp
+	}
+
+	request.RequestUrl = []string{}
+	if requestUrls, ok := s.D.GetOkExists("request_urls"); ok {
+		interfaces := requestUrls.([]interface{})
+		tmp := make([]string, len(interfaces))
+		for i := range interfaces {
+			if interfaces[i] != nil {
+				tmp[i] = interfaces[i].(string)
+			}
+		}
+		request.RequestUrl = tmp
+	}
+
+	request.ResponseCode = []int{}
+	if responseCodes, ok := s.D.GetOkExists("response_codes"); ok {
+		interfaces := responseCodes.([]interface{})
+		tmp := make([]int, len(interfaces))
+		for i := range interfaces {
+			if interfaces[i] != nil {
+				tmp[i] = interfaces[i].(int)
+			}
+		}
+		request.ResponseCode = tmp
+	}
+
+	request.ThreatFeedKey = []string{}
+	if threatFeedKeys, ok := s.D.GetOkExists("threat_feed_keys"); ok {
+		interfaces := threatFeedKeys.([]interface{})
+		tmp := make([]string, len(interfaces))
+		for i := range interfaces {
+			if interfaces[i] != nil {
+				tmp[i] = interfaces[i].(string)
+			}
+		}
+		request.ThreatFeedKey = tmp
+	}
+
+	request.UserAgent = []string{}
+	if userAgents, ok := s.D.GetOkExists("user_agents"); ok {
+		interfaces := userAgents.([]interface{})
+		tmp := make([]string, len(interfaces))
+		for i := range interfaces {
+			if interfaces[i] != nil {
+				tmp[i] = interfaces[i].(string)
+			}
+		}
+		request.UserAgent = tmp
+	}
+
+	if textContains, ok := s.D.GetOkExists("text_contains"); ok {
+		tmp := textContains.(string)
+		request.TextContains = &tmp
+	}
+
+	if timeObservedGreaterThanOrEqualTo, ok := s.D.GetOkExists("time_observed_greater_than_or_equal_to"); ok {
+		tmp, err := time.Parse(time.RFC3339, timeObservedGreaterThanOrEqualTo.(string))
+		if err != nil {
+			return err
+		}
+		request.TimeObservedGreaterThanOrEqualTo = &oci_common.SDKTime{Time: tmp}
+	}
+
+	if timeObservedLessThan, ok := s.D.GetOkExists("time_observed_less_than"); ok {
+		tmp, err := time.Parse(time.RFC3339, timeObservedLessThan.(string))
+		if err != nil {
+			return err
+		}
+		request.TimeObservedLessThan = &oci_common.SDKTime{Time: tmp}
+	}
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "waas")
+
+	response, err := s.Client.ListWafLogs(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response
+	request.Page = s.Res.OpcNextPage
+
+	for request.Page != nil {
+		listResponse, err := s.Client.ListWafLogs(context.Background(), request)
+		if err != nil {
+			return err
+		}
+
+		s.Res.Items = append(s.Res.Items, listResponse.Items...)
+		request.Page = listResponse.OpcNextPage
+	}
+
+	return nil
+}
+
+func (s *WaasWafLogsDataSourceCrud) SetData() error {
+	if s.Res == nil {
+		return nil
+	}
+
+	s.D.SetId(GenerateDataSourceID())
+	resources := []map[string]interface{}{}
+
+	for _, r := range s.Res.Items {
+		wafLog := map[string]interface{}{}
+
+		if r.AccessRuleKey != nil {
+			wafLog["access_rule_key"] = *r.AccessRuleKey
+		}
+
+		if r.Action != nil {
+			wafLog["action"] = *r.Action
+		}
+
+		if r.AddressRateLimitingKey != nil {
+			wafLog["address_rate_limiting_key"] = *r.AddressRateLimitingKey
+		}
+
+		if r.CaptchaAction != nil {
+			wafLog["captcha_action"] = *r.CaptchaAction
+		}
+
+		if r.CaptchaExpected != nil {
+			wafLog["captcha_expected"] = *r.CaptchaExpected
+		}
+
+		if r.CaptchaFailCount != nil {
+			wafLog["captcha_fail_count"] = *r.CaptchaFailCount
+		}
+
+		if r.CaptchaReceived != nil {
+			wafLog["captcha_received"] = *r.CaptchaReceived
+		}
+
+		if r.ClientAddress != nil {
+			wafLog["client_address"] = *r.ClientAddress
+		}
+
+		if r.CountryCode != nil {
+			wafLog["country_code"] = *r.CountryCode
+		}
+
+		if r.CountryName != nil {
+			wafLog["country_name"] = *r.CountryName
+		}
+
+		if r.Device != nil {
+			wafLog["device"] = *r.Device
+		}
+
+		if r.Domain != nil {
+			wafLog["domain"] = *r.Domain
+		}
+
+		if r.Fingerprint != nil {
+			wafLog["fingerprint"] = *r.Fingerprint
+		}
+
+		wafLog["http_headers"] = r.HttpHeaders
+
+		if r.HttpMethod != nil {
+			wafLog["http_method"] = *r.HttpMethod
+		}
+
+		if r.IncidentKey != nil {
+			wafLog["incident_key"] = *r.IncidentKey
+		}
+
+		if r.LogType != nil {
+			wafLog["log_type"] = *r.LogType
+		}
+
+		if r.OriginAddress != nil {
+			wafLog["origin_address"] = *r.OriginAddress
+		}
+
+		if r.OriginResponseTime != nil {
+			wafLog["origin_response_time"] = *r.OriginResponseTime
+		}
+
+		wafLog["protection_rule_detections"] = r.ProtectionRuleDetections
+
+		if r.Referrer != nil {
+			wafLog["referrer"] = *r.Referrer
+		}
+
+		wafLog["request_headers"] = r.RequestHeaders
+
+		if r.RequestUrl != nil {
+			wafLog["request_url"] = *r.RequestUrl
+		}
+
+		if r.ResponseCode != nil {
+			wafLog["response_code"] = *r.ResponseCode
+		}
+
+		if r.ResponseSize != nil {
+			wafLog["response_size"] = *r.ResponseSize
+		}
+
+		if r.ThreatFeedKey != nil {
+			wafLog["threat_feed_key"] = *r.ThreatFeedKey
+		}
+
+		if r.Timestamp != nil {
+			wafLog["timestamp"] = r.Timestamp.String()
+		}
+
+		if r.UserAgent != nil {
+			wafLog["user_agent"] = *r.UserAgent
+		}
+
+		resources = append(resources, wafLog)
+	}
+
+	if f, fOk := s.D.GetOkExists("filter"); fOk {
+		resources = ApplyFilters(f.(*schema.Set), resources, WaasWafLogsDataSource().Schema["waf_logs"].Elem.(*schema.Resource).Schema)
+	}
+
+	if err := s.D.Set("waf_logs", resources); err != nil {
+		return err
+	}
+
+	return nil
+}
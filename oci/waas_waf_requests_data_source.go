@@ -0,0 +1,156 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	oci_common "github.com/oracle/oci-go-sdk/common"
+	oci_waas "github.com/oracle/oci-go-sdk/waas"
+)
+
+func WaasWafRequestsDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: readWaasWafRequests,
+		Schema: map[string]*schema.Schema{
+			"filter": dataSourceFiltersSchema(),
+			"waas_policy_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"time_observed_greater_than_or_equal_to": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRFC3339TimeString,
+			},
+			"time_observed_less_than": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRFC3339TimeString,
+			},
+			"waf_requests": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"time_observed": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"time_range_in_seconds": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func readWaasWafRequests(d *schema.ResourceData, m interface{}) error {
+	sync := &WaasWafRequestsDataSourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).waasClient
+
+	return ReadResource(sync)
+}
+
+type WaasWafRequestsDataSourceCrud struct {
+	D      *schema.ResourceData
+	Client *oci_waas.WaasClient
+	Res    *oci_waas.ListWafRequestsResponse
+}
+
+func (s *WaasWafRequestsDataSourceCrud) VoidState() {
+	s.D.SetId("")
+}
+
+func (s *WaasWafRequestsDataSourceCrud) Get() error {
+	request := oci_waas.ListWafRequestsRequest{}
+
+	waasPolicyId := s.D.Get("waas_policy_id").(string)
+	request.WaasPolicyId = &waasPolicyId
+
+	if timeObservedGreaterThanOrEqualTo, ok := s.D.GetOkExists("time_observed_greater_than_or_equal_to"); ok {
+		tmp, err := time.Parse(time.RFC3339, timeObservedGreaterThanOrEqualTo.(string))
+		if err != nil {
+			return err
+		}
+		request.TimeObservedGreaterThanOrEqualTo = &oci_common.SDKTime{Time: tmp}
+	}
+
+	if timeObservedLessThan, ok := s.D.GetOkExists("time_observed_less_than"); ok {
+		tmp, err := time.Parse(time.RFC3339, timeObservedLessThan.(string))
+		if err != nil {
+			return err
+		}
+		request.TimeObservedLessThan = &oci_common.SDKTime{Time: tmp}
+	}
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "waas")
+
+	response, err := s.Client.ListWafRequests(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response
+	request.Page = s.Res.OpcNextPage
+
+	for request.Page != nil {
+		listResponse, err := s.Client.ListWafRequests(context.Background(), request)
+		if err != nil {
+			return err
+		}
+
+		s.Res.Items = append(s.Res.Items, listResponse.Items...)
+		request.Page = listResponse.OpcNextPage
+	}
+
+	return nil
+}
+
+func (s *WaasWafRequestsDataSourceCrud) SetData() error {
+	if s.Res == nil {
+		return nil
+	}
+
+	s.D.SetId(GenerateDataSourceID())
+	resources := []map[string]interface{}{}
+
+	for _, r := range s.Res.Items {
+		wafRequest := map[string]interface{}{}
+
+		if r.Count != nil {
+			wafRequest["count"] = *r.Count
+		}
+
+		if r.TimeObserved != nil {
+			wafRequest["time_observed"] = r.TimeObserved.String()
+		}
+
+		if r.TimeRangeInSeconds != nil {
+			wafRequest["time_range_in_seconds"] = *r.TimeRangeInSeconds
+		}
+
+		resources = append(resources, wafRequest)
+	}
+
+	if f, fOk := s.D.GetOkExists("filter"); fOk {
+		resources = ApplyFilters(f.(*schema.Set), resources, WaasWafRequestsDataSource().Schema["waf_requests"].Elem.(*schema.Resource).Schema)
+	}
+
+	if err := s.D.Set("waf_requests", resources); err != nil {
+		return err
+	}
+
+	return nil
+}
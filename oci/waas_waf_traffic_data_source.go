@@ -0,0 +1,180 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	oci_common "github.com/oracle/oci-go-sdk/common"
+	oci_waas "github.com/oracle/oci-go-sdk/waas"
+)
+
+func WaasWafTrafficDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: readWaasWafTraffic,
+		Schema: map[string]*schema.Schema{
+			"filter": dataSourceFiltersSchema(),
+			"waas_policy_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"time_observed_greater_than_or_equal_to": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRFC3339TimeString,
+			},
+			"time_observed_less_than": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRFC3339TimeString,
+			},
+			"waf_traffic": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"compartment_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tenancy_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"time_observed": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"time_range_in_seconds": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"traffic_in_bytes": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"waas_policy_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func readWaasWafTraffic(d *schema.ResourceData, m interface{}) error {
+	sync := &WaasWafTrafficDataSourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).waasClient
+
+	return ReadResource(sync)
+}
+
+type WaasWafTrafficDataSourceCrud struct {
+	D      *schema.ResourceData
+	Client *oci_waas.WaasClient
+	Res    *oci_waas.ListWafTrafficResponse
+}
+
+func (s *WaasWafTrafficDataSourceCrud) VoidState() {
+	s.D.SetId("")
+}
+
+func (s *WaasWafTrafficDataSourceCrud) Get() error {
+	request := oci_waas.ListWafTrafficRequest{}
+
+	waasPolicyId := s.D.Get("waas_policy_id").(string)
+	request.WaasPolicyId = &waasPolicyId
+
+	if timeObservedGreaterThanOrEqualTo, ok := s.D.GetOkExists("time_observed_greater_than_or_equal_to"); ok {
+		tmp, err := time.Parse(time.RFC3339, timeObservedGreaterThanOrEqualTo.(string))
+		if err != nil {
+			return err
+		}
+		request.TimeObservedGreaterThanOrEqualTo = &oci_common.SDKTime{Time: tmp}
+	}
+
+	if timeObservedLessThan, ok := s.D.GetOkExists("time_observed_less_than"); ok {
+		tmp, err := time.Parse(time.RFC3339, timeObservedLessThan.(string))
+		if err != nil {
+			return err
+		}
+		request.TimeObservedLessThan = &oci_common.SDKTime{Time: tmp}
+	}
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "waas")
+
+	response, err := s.Client.ListWafTraffic(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response
+	request.Page = s.Res.OpcNextPage
+
+	for request.Page != nil {
+		listResponse, err := s.Client.ListWafTraffic(context.Background(), request)
+		if err != nil {
+			return err
+		}
+
+		s.Res.Items = append(s.Res.Items, listResponse.Items...)
+		request.Page = listResponse.OpcNextPage
+	}
+
+	return nil
+}
+
+func (s *WaasWafTrafficDataSourceCrud) SetData() error {
+	if s.Res == nil {
+		return nil
+	}
+
+	s.D.SetId(GenerateDataSourceID())
+	resources := []map[string]interface{}{}
+
+	for _, r := range s.Res.Items {
+		wafTrafficDatum := map[string]interface{}{}
+
+		if r.CompartmentId != nil {
+			wafTrafficDatum["compartment_id"] = *r.CompartmentId
+		}
+
+		if r.TenancyId != nil {
+			wafTrafficDatum["tenancy_id"] = *r.TenancyId
+		}
+
+		if r.TimeObserved != nil {
+			wafTrafficDatum["time_observed"] = r.TimeObserved.String()
+		}
+
+		if r.TimeRangeInSeconds != nil {
+			wafTrafficDatum["time_range_in_seconds"] = *r.TimeRangeInSeconds
+		}
+
+		if r.TrafficInBytes != nil {
+			wafTrafficDatum["traffic_in_bytes"] = *r.TrafficInBytes
+		}
+
+		if r.WaasPolicyId != nil {
+			wafTrafficDatum["waas_policy_id"] = *r.WaasPolicyId
+		}
+
+		resources = append(resources, wafTrafficDatum)
+	}
+
+	if f, fOk := s.D.GetOkExists("filter"); fOk {
+		resources = ApplyFilters(f.(*schema.Set), resources, WaasWafTrafficDataSource().Schema["waf_traffic"].Elem.(*schema.Resource).Schema)
+	}
+
+	if err := s.D.Set("waf_traffic", resources); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2017, 2019, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/terraform-providers/terraform-provider-oci/httpreplay"
+)
+
+var (
+	wafTimeObservedGreaterThanOrEqualToStr = "2019-09-01T00:00:00Z"
+	wafTimeObservedLessThanStr             = "2019-09-01T01:00:00Z"
+
+	wafTrafficDataSourceRepresentation = map[string]interface{}{
+		"waas_policy_id":                         Representation{repType: Required, create: `${oci_waas_waas_policy.test_waas_policy.id}`},
+		"time_observed_greater_than_or_equal_to": Representation{repType: Optional, create: wafTimeObservedGreaterThanOrEqualToStr},
+		"time_observed_less_than":                Representation{repType: Optional, create: wafTimeObservedLessThanStr},
+	}
+
+	wafBlockedRequestDataSourceRepresentation = representationCopyWithNewProperties(wafTrafficDataSourceRepresentation, map[string]interface{}{
+		"waf_features": Representation{repType: Optional, create: []string{`PROTECTION_RULES`}},
+	})
+
+	wafLogDataSourceRepresentation = representationCopyWithNewProperties(wafTrafficDataSourceRepresentation, map[string]interface{}{
+		"actions":   Representation{repType: Optional, create: []string{`BLOCK`, `DETECT`}},
+		"log_types": Representation{repType: Optional, create: []string{`PROTECTION_RULES`}},
+	})
+
+	WafTrafficResourceConfig = WaasPolicyRequiredOnlyResource
+)
+
+func TestWaasWafTrafficResource_basic(t *testing.T) {
+	httpreplay.SetScenario("TestWaasWafTrafficResource_basic")
+	defer httpreplay.SaveScenario()
+
+	provider := testAccProvider
+	config := testProviderConfig()
+
+	compartmentId := getEnvSettingWithBlankDefault("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Providers: map[string]terraform.ResourceProvider{
+			"oci": provider,
+		},
+		Steps: []resource.TestStep{
+			// verify datasources
+			{
+				Config: config +
+					generateDataSourceFromRepresentationMap("oci_waas_waf_traffic", "test_waf_traffic", Optional, Create, wafTrafficDataSourceRepresentation) +
+					generateDataSourceFromRepresentationMap("oci_waas_waf_requests", "test_waf_requests", Optional, Create, wafTrafficDataSourceRepresentation) +
+					generateDataSourceFromRepresentationMap("oci_waas_waf_blocked_requests", "test_waf_blocked_requests", Optional, Create, wafBlockedRequestDataSourceRepresentation) +
+					generateDataSourceFromRepresentationMap("oci_waas_waf_logs", "test_waf_logs", Optional, Create, wafLogDataSourceRepresentation) +
+					compartmentIdVariableStr + WafTrafficResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.oci_waas_waf_traffic.test_waf_traffic", "waas_policy_id"),
+					resource.TestCheckResourceAttr("data.oci_waas_waf_traffic.test_waf_traffic", "time_observed_less_than", wafTimeObservedLessThanStr),
+					resource.TestCheckResourceAttrSet("data.oci_waas_waf_traffic.test_waf_traffic", "waf_traffic.#"),
+
+					resource.TestCheckResourceAttrSet("data.oci_waas_waf_requests.test_waf_requests", "waas_policy_id"),
+					resource.TestCheckResourceAttrSet("data.oci_waas_waf_requests.test_waf_requests", "waf_requests.#"),
+
+					resource.TestCheckResourceAttr("data.oci_waas_waf_blocked_requests.test_waf_blocked_requests", "waf_features.#", "1"),
+					resource.TestCheckResourceAttrSet("data.oci_waas_waf_blocked_requests.test_waf_blocked_requests", "waf_blocked_requests.#"),
+
+					resource.TestCheckResourceAttr("data.oci_waas_waf_logs.test_waf_logs", "actions.#", "2"),
+					resource.TestCheckResourceAttr("data.oci_waas_waf_logs.test_waf_logs", "log_types.#", "1"),
+					resource.TestCheckResourceAttrSet("data.oci_waas_waf_logs.test_waf_logs", "waf_logs.#"),
+				),
+			},
+		},
+	})
+}